@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+data "influxdb_dbrps" "signals" {
+  org_id   = data.influxdb_organization.iot.id
+  database = "signals"
+}
+
+output "signals_dbrps" {
+  value = data.influxdb_dbrps.signals
+}
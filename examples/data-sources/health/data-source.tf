@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {
+  allow_unauthenticated = true
+}
+
+data "influxdb_health" "this" {}
+
+output "influxdb_version" {
+  value = data.influxdb_health.this.version
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {
+  allow_unauthenticated = true
+}
+
+data "influxdb_ready" "this" {}
+
+output "influxdb_up" {
+  value = data.influxdb_ready.this.up
+}
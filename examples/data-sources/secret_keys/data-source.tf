@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+data "influxdb_secret_keys" "iot" {
+  org_id = data.influxdb_organization.iot.id
+}
+
+output "has_slack_webhook_url" {
+  value = contains(data.influxdb_secret_keys.iot.keys, "SLACK_WEBHOOK_URL")
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+data "influxdb_template_export" "signals" {
+  org_id         = data.influxdb_organization.iot.id
+  resource_kinds = ["Bucket", "Label", "Task"]
+  labels         = ["signals"]
+}
+
+output "signals_template" {
+  value = data.influxdb_template_export.signals.yaml
+}
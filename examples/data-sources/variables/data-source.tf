@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_variables" "all" {}
+
+output "all_variables" {
+  value = data.influxdb_variables.all
+}
@@ -12,11 +12,22 @@ data "influxdb_organization" "iot" {
   name = "IoT"
 }
 
+resource "influxdb_label" "production" {
+  org_id = data.influxdb_organization.iot.id
+  name   = "production"
+}
+
 resource "influxdb_bucket" "signals" {
   org_id           = data.influxdb_organization.iot.id
   name             = "signals"
   description      = "This is a bucket to store signals"
   retention_period = 604800
+  label_ids        = [influxdb_label.production.id]
+
+  timeouts {
+    create = "5m"
+    delete = "5m"
+  }
 }
 
 output "signals_bucket" {
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_bucket" "signals" {
+  name = "signals"
+}
+
+resource "influxdb_user" "analyst" {
+  name     = "analyst"
+  password = "analyst-password"
+}
+
+resource "influxdb_bucket_owner" "signals_analyst" {
+  bucket_id = data.influxdb_bucket.signals.id
+  user_id   = influxdb_user.analyst.id
+}
+
+output "signals_analyst_bucket_owner" {
+  value = influxdb_bucket_owner.signals_analyst.id
+}
@@ -0,0 +1,67 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_check" "cpu_threshold" {
+  name   = "CPU usage"
+  org_id = data.influxdb_organization.iot.id
+  type   = "threshold"
+  every  = "1m"
+  offset = "10s"
+  query = {
+    text = <<-EOT
+      from(bucket: "telegraf")
+        |> range(start: -1m)
+        |> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage_user")
+        |> aggregateWindow(every: 1m, fn: mean, createEmpty: false)
+    EOT
+  }
+  status_message_template = "Check: $${ r._check_name } is: $${ r._level }"
+  thresholds = [
+    {
+      type  = "greater"
+      level = "CRIT"
+      value = 90
+    },
+    {
+      type  = "range"
+      level = "WARN"
+      min   = 70
+      max   = 90
+    },
+  ]
+}
+
+resource "influxdb_check" "cpu_deadman" {
+  name   = "CPU deadman"
+  org_id = data.influxdb_organization.iot.id
+  type   = "deadman"
+  every  = "1m"
+  query = {
+    text = <<-EOT
+      from(bucket: "telegraf")
+        |> range(start: -5m)
+        |> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage_user")
+    EOT
+  }
+  status_message_template = "No CPU data received from $${ r.host }"
+  deadman = {
+    level      = "CRIT"
+    time_since = "90s"
+    stale_time = "10m"
+  }
+}
+
+output "cpu_threshold" {
+  value = influxdb_check.cpu_threshold
+}
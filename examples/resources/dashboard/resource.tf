@@ -0,0 +1,89 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_dashboard" "system" {
+  name        = "System"
+  description = "Host metrics collected by Telegraf"
+  org_id      = data.influxdb_organization.iot.id
+  cells = [
+    {
+      name = "CPU usage"
+      x    = 0
+      y    = 0
+      w    = 8
+      h    = 4
+      xy = {
+        geom = "line"
+        queries = [
+          <<-EOT
+            from(bucket: "telegraf")
+              |> range(start: v.timeRangeStart, stop: v.timeRangeStop)
+              |> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage_user")
+              |> aggregateWindow(every: v.windowPeriod, fn: mean, createEmpty: false)
+          EOT
+        ]
+      }
+    },
+    {
+      name = "Memory used"
+      x    = 8
+      y    = 0
+      w    = 4
+      h    = 2
+      single_stat = {
+        suffix         = "%"
+        decimal_places = 1
+        queries = [
+          <<-EOT
+            from(bucket: "telegraf")
+              |> range(start: v.timeRangeStart, stop: v.timeRangeStop)
+              |> filter(fn: (r) => r._measurement == "mem" and r._field == "used_percent")
+              |> last()
+          EOT
+        ]
+      }
+    },
+    {
+      name = "Disk usage"
+      x    = 8
+      y    = 2
+      w    = 4
+      h    = 2
+      table = {
+        queries = [
+          <<-EOT
+            from(bucket: "telegraf")
+              |> range(start: v.timeRangeStart, stop: v.timeRangeStop)
+              |> filter(fn: (r) => r._measurement == "disk" and r._field == "used_percent")
+              |> last()
+          EOT
+        ]
+      }
+    },
+    {
+      name = "About"
+      x    = 0
+      y    = 4
+      w    = 12
+      h    = 1
+      markdown = {
+        note = "Metrics are collected every 10 seconds."
+      }
+    },
+  ]
+}
+
+output "system_dashboard_id" {
+  value = influxdb_dashboard.system.id
+}
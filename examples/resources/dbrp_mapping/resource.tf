@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_bucket" "signals" {
+  org_id           = data.influxdb_organization.iot.id
+  name             = "signals"
+  retention_period = 604800
+}
+
+resource "influxdb_dbrp_mapping" "signals" {
+  database         = "signals"
+  retention_policy = "autogen"
+  default          = true
+  bucket_id        = influxdb_bucket.signals.id
+  org_id           = data.influxdb_organization.iot.id
+}
+
+output "signals_dbrp_mapping" {
+  value = influxdb_dbrp_mapping.signals
+}
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+data "influxdb_bucket" "signals" {
+  name = "signals"
+}
+
+resource "influxdb_label" "production" {
+  name   = "production"
+  org_id = data.influxdb_organization.iot.id
+}
+
+resource "influxdb_label_assignment" "signals_production" {
+  label_id      = influxdb_label.production.id
+  resource_type = "buckets"
+  resource_id   = data.influxdb_bucket.signals.id
+}
+
+output "signals_production_label_assignment" {
+  value = influxdb_label_assignment.signals_production.id
+}
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+data "influxdb_user" "admin" {
+  id = "0a1b2c3d4e5f6a7b"
+}
+
+resource "influxdb_user" "analyst" {
+  name     = "analyst"
+  password = "analyst-password"
+}
+
+resource "influxdb_org_members" "iot" {
+  org_id     = data.influxdb_organization.iot.id
+  member_ids = [influxdb_user.analyst.id]
+  owner_ids  = [data.influxdb_user.admin.id]
+}
+
+output "iot_org_members" {
+  value = influxdb_org_members.iot
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_user" "analyst" {
+  name     = "analyst"
+  password = "analyst-password"
+}
+
+resource "influxdb_org_owner" "iot_analyst" {
+  org_id  = data.influxdb_organization.iot.id
+  user_id = influxdb_user.analyst.id
+}
+
+output "iot_analyst_org_owner" {
+  value = influxdb_org_owner.iot_analyst.id
+}
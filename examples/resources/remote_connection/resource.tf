@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+variable "cloud_token" {
+  type      = string
+  sensitive = true
+}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_remote_connection" "cloud" {
+  name             = "cloud"
+  description      = "Central InfluxDB instance"
+  org_id           = data.influxdb_organization.iot.id
+  remote_url       = "https://influxdb.example.com"
+  remote_org_id    = "0a1b2c3d4e5f6a7b"
+  remote_api_token = var.cloud_token
+}
+
+output "cloud_remote_connection" {
+  value = influxdb_remote_connection.cloud.id
+}
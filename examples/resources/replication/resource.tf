@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+variable "cloud_token" {
+  type      = string
+  sensitive = true
+}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+data "influxdb_bucket" "signals" {
+  name = "signals"
+}
+
+resource "influxdb_remote_connection" "cloud" {
+  name             = "cloud"
+  org_id           = data.influxdb_organization.iot.id
+  remote_url       = "https://influxdb.example.com"
+  remote_org_id    = "0a1b2c3d4e5f6a7b"
+  remote_api_token = var.cloud_token
+}
+
+resource "influxdb_replication" "signals" {
+  name                    = "signals-to-cloud"
+  org_id                  = data.influxdb_organization.iot.id
+  remote_id               = influxdb_remote_connection.cloud.id
+  local_bucket_id         = data.influxdb_bucket.signals.id
+  remote_bucket_id        = "1a2b3c4d5e6f7a8b"
+  max_queue_size_bytes    = 134217728
+  max_age_seconds         = 86400
+  drop_non_retryable_data = true
+}
+
+output "signals_replication_queue_size" {
+  value = influxdb_replication.signals.current_queue_size_bytes
+}
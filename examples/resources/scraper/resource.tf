@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_bucket" "metrics" {
+  org_id           = data.influxdb_organization.iot.id
+  name             = "metrics"
+  description      = "Metrics scraped from Prometheus endpoints"
+  retention_period = 604800
+}
+
+resource "influxdb_scraper" "influxdb" {
+  name      = "influxdb"
+  url       = "http://localhost:8086/metrics"
+  type      = "prometheus"
+  bucket_id = influxdb_bucket.metrics.id
+  org_id    = data.influxdb_organization.iot.id
+}
+
+output "influxdb_scraper" {
+  value = influxdb_scraper.influxdb
+}
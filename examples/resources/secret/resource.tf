@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_secret" "slack_webhook_url" {
+  org_id        = data.influxdb_organization.iot.id
+  key           = "SLACK_WEBHOOK_URL"
+  value         = var.slack_webhook_url
+  value_version = 1
+}
+
+output "slack_webhook_url_secret" {
+  value = influxdb_secret.slack_webhook_url.id
+}
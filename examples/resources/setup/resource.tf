@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+variable "operator_token" {
+  type      = string
+  sensitive = true
+}
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+# The setup endpoint does not require authentication. Configuring the provider
+# with the pre-set operator token lets the other resources use it once the
+# instance is set up.
+provider "influxdb" {
+  token = var.operator_token
+}
+
+resource "influxdb_setup" "initial" {
+  username               = "admin"
+  password               = var.admin_password
+  org                    = "IoT"
+  bucket                 = "signals"
+  retention_period_hours = 720
+  token                  = var.operator_token
+}
+
+resource "influxdb_bucket" "events" {
+  name   = "events"
+  org_id = influxdb_setup.initial.org_id
+}
+
+output "initial_bucket_id" {
+  value = influxdb_setup.initial.bucket_id
+}
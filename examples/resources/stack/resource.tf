@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_stack" "monitoring" {
+  org_id      = data.influxdb_organization.iot.id
+  name        = "monitoring"
+  description = "Docker monitoring community template"
+  template_urls = [
+    "https://raw.githubusercontent.com/influxdata/community-templates/master/docker/docker.yml",
+  ]
+  env_refs = {
+    "docker_bucket" = "docker"
+  }
+}
+
+resource "influxdb_stack" "signals" {
+  org_id = data.influxdb_organization.iot.id
+  name   = "signals"
+  templates = [
+    file("${path.module}/signals.yml"),
+  ]
+}
+
+output "monitoring_stack_resources" {
+  value = influxdb_stack.monitoring.resources
+}
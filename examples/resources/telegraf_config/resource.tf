@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_telegraf_config" "system" {
+  name             = "system"
+  description      = "System metrics"
+  org_id           = data.influxdb_organization.iot.id
+  metadata_buckets = ["telegraf"]
+  config           = <<-EOT
+    [agent]
+      interval = "10s"
+
+    [[outputs.influxdb_v2]]
+      urls         = ["$INFLUX_HOST"]
+      token        = "$INFLUX_TOKEN"
+      organization = "IoT"
+      bucket       = "telegraf"
+
+    [[inputs.cpu]]
+      percpu   = true
+      totalcpu = true
+
+    [[inputs.mem]]
+  EOT
+}
+
+output "system_config_url" {
+  value = influxdb_telegraf_config.system.config_url
+}
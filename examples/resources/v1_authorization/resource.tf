@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+variable "grafana_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+data "influxdb_bucket" "signals" {
+  name = "signals"
+}
+
+resource "influxdb_v1_authorization" "grafana" {
+  token            = "grafana"
+  password         = var.grafana_password
+  password_version = 1
+  description      = "Grafana InfluxQL data source"
+  org_id           = data.influxdb_organization.iot.id
+
+  permissions = [
+    {
+      action = "read"
+      resource = {
+        id   = data.influxdb_bucket.signals.id
+        type = "buckets"
+      }
+    },
+  ]
+}
+
+output "grafana_v1_authorization" {
+  value = influxdb_v1_authorization.grafana.token
+}
@@ -0,0 +1,52 @@
+terraform {
+  required_providers {
+    influxdb = {
+      source = "komminarlabs/influxdb"
+    }
+  }
+}
+
+provider "influxdb" {}
+
+data "influxdb_organization" "iot" {
+  name = "IoT"
+}
+
+resource "influxdb_variable" "bucket" {
+  name        = "bucket"
+  description = "Buckets in the organization"
+  org_id      = data.influxdb_organization.iot.id
+  type        = "query"
+  query = {
+    language = "flux"
+    query    = <<-EOT
+      buckets()
+        |> filter(fn: (r) => r.name !~ /^_/)
+        |> rename(columns: {name: "_value"})
+        |> keep(columns: ["_value"])
+    EOT
+  }
+}
+
+resource "influxdb_variable" "host" {
+  name            = "host"
+  org_id          = data.influxdb_organization.iot.id
+  type            = "constant"
+  constant_values = ["server01", "server02", "server03"]
+  selected        = ["server01"]
+}
+
+resource "influxdb_variable" "environment" {
+  name   = "environment"
+  org_id = data.influxdb_organization.iot.id
+  type   = "map"
+  map_values = {
+    "Production" = "prod"
+    "Staging"    = "staging"
+  }
+  selected = ["Production"]
+}
+
+output "host_variable" {
+  value = influxdb_variable.host
+}
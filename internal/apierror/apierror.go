@@ -0,0 +1,89 @@
+// Package apierror classifies errors returned by the InfluxDB API.
+//
+// The influxdb-client-go library surfaces API errors in two shapes: the raw
+// HTTP service returns a *http.Error carrying the status code, while the
+// generated domain client flattens the response into an error whose message
+// is prefixed with the InfluxDB error code, e.g. "not found: bucket not found".
+// The helpers in this package understand both.
+package apierror
+
+import (
+	"errors"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Kind is the class of an InfluxDB API error.
+type Kind int
+
+const (
+	// Unknown is any error that is not classified more specifically.
+	Unknown Kind = iota
+	// Conflict means that the object clashes with an existing one, e.g. by name.
+	Conflict
+	// Forbidden means that the token lacks a permission for the operation.
+	Forbidden
+	// NotFound means that the object, or an object it references, does not exist.
+	NotFound
+	// Unauthorized means that the provider credentials were rejected.
+	Unauthorized
+	// UnprocessableEntity means that InfluxDB rejected the request content.
+	UnprocessableEntity
+)
+
+// kindsByCode maps InfluxDB error codes to their kind.
+var kindsByCode = map[domain.ErrorCode]Kind{
+	domain.ErrorCodeConflict:            Conflict,
+	domain.ErrorCodeForbidden:           Forbidden,
+	domain.ErrorCodeNotFound:            NotFound,
+	domain.ErrorCodeUnauthorized:        Unauthorized,
+	domain.ErrorCodeUnprocessableEntity: UnprocessableEntity,
+}
+
+// kindsByStatus maps HTTP status codes to their kind.
+var kindsByStatus = map[int]Kind{
+	nethttp.StatusConflict:            Conflict,
+	nethttp.StatusForbidden:           Forbidden,
+	nethttp.StatusNotFound:            NotFound,
+	nethttp.StatusUnauthorized:        Unauthorized,
+	nethttp.StatusUnprocessableEntity: UnprocessableEntity,
+}
+
+// Classify returns the kind of err. The InfluxDB error code takes precedence
+// over the HTTP status, as InfluxDB reports e.g. name conflicts with status 422.
+func Classify(err error) Kind {
+	var httpErr *http.Error
+	if errors.As(err, &httpErr) && httpErr.StatusCode != 0 {
+		if kind, ok := kindsByCode[domain.ErrorCode(httpErr.Code)]; ok {
+			return kind
+		}
+
+		return kindsByStatus[httpErr.StatusCode]
+	}
+
+	for ; err != nil; err = errors.Unwrap(err) {
+		message := err.Error()
+		for code, kind := range kindsByCode {
+			if strings.HasPrefix(message, string(code)+": ") {
+				return kind
+			}
+		}
+		for status, kind := range kindsByStatus {
+			if strings.HasPrefix(message, strconv.Itoa(status)+" ") {
+				return kind
+			}
+		}
+	}
+
+	return Unknown
+}
+
+// IsNotFound reports whether err, or any error it wraps, means that the
+// requested object does not exist.
+func IsNotFound(err error) bool {
+	return Classify(err) == NotFound
+}
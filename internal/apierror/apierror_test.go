@@ -0,0 +1,63 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/influxdata/influxdb-client-go/v2/api/http"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":                 {err: nil, want: false},
+		"http 404":            {err: &http.Error{StatusCode: 404}, want: true},
+		"http 404 wrapped":    {err: fmt.Errorf("failed to get bucket: %w", &http.Error{StatusCode: 404}), want: true},
+		"http 400":            {err: &http.Error{StatusCode: 400, Code: "invalid", Message: "id not found"}, want: false},
+		"http transport":      {err: http.NewError(errors.New("connection refused")), want: false},
+		"domain not found":    {err: errors.New("not found: bucket not found"), want: true},
+		"domain wrapped":      {err: fmt.Errorf("failed to list members: %w", errors.New("not found: organization not found")), want: true},
+		"domain non json":     {err: errors.New("404 Not Found: 404 page not found"), want: true},
+		"client lookup":       {err: errors.New("bucket 'example' not found"), want: false},
+		"domain unauthorized": {err: errors.New("unauthorized: unauthorized access"), want: false},
+		"contains 404 in id":  {err: errors.New("invalid: id 0a404b is invalid"), want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotFound(test.err); got != test.want {
+				t.Errorf("IsNotFound(%v) = %t, want %t", test.err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want Kind
+	}{
+		"nil":                    {err: nil, want: Unknown},
+		"http 401":               {err: &http.Error{StatusCode: 401}, want: Unauthorized},
+		"http 403":               {err: &http.Error{StatusCode: 403, Code: "forbidden", Message: "insufficient permissions"}, want: Forbidden},
+		"http 409":               {err: &http.Error{StatusCode: 409}, want: Conflict},
+		"http 422 conflict code": {err: &http.Error{StatusCode: 422, Code: "conflict", Message: "bucket with name test already exists"}, want: Conflict},
+		"http 422":               {err: &http.Error{StatusCode: 422, Code: "unprocessable entity"}, want: UnprocessableEntity},
+		"http 500":               {err: &http.Error{StatusCode: 500, Code: "internal error"}, want: Unknown},
+		"domain unauthorized":    {err: errors.New("unauthorized: unauthorized access"), want: Unauthorized},
+		"domain forbidden":       {err: fmt.Errorf("failed to add label: %w", errors.New("forbidden: insufficient permissions")), want: Forbidden},
+		"domain non json 403":    {err: errors.New("403 Forbidden"), want: Forbidden},
+		"plain":                  {err: errors.New("connection refused"), want: Unknown},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Classify(test.err); got != test.want {
+				t.Errorf("Classify(%v) = %d, want %d", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package apierror
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Diagnostic returns an error diagnostic for the failed API call err.
+//
+// summary and detail describe the failed operation, e.g. "Error creating bucket"
+// and "Could not create bucket". Classified errors get the kind appended to the
+// summary and a remediation hint appended to the detail. permission is the
+// permission the operation requires, as returned by Permission, and is named in
+// the hint when the token lacks it.
+func Diagnostic(summary string, detail string, err error, permission string) diag.Diagnostic {
+	kind := Classify(err)
+	if kind == Unknown {
+		if detail == "" {
+			return diag.NewErrorDiagnostic(summary, err.Error())
+		}
+
+		return diag.NewErrorDiagnostic(summary, detail+", unexpected error: "+err.Error())
+	}
+
+	message := err.Error()
+	if detail != "" {
+		message = detail + ": " + message
+	}
+
+	return diag.NewErrorDiagnostic(summary+": "+kind.title(), message+"\n\n"+kind.hint(permission))
+}
+
+// Permission returns the InfluxDB permission for action on resources of
+// resourceType, scoped to the organization orgID when it is not empty.
+func Permission(action domain.PermissionAction, orgID string, resourceType domain.ResourceType) string {
+	if orgID == "" {
+		return fmt.Sprintf("%s:%s", action, resourceType)
+	}
+
+	return fmt.Sprintf("%s:orgs/%s/%s", action, orgID, resourceType)
+}
+
+// title returns the short description of the kind used in diagnostic summaries.
+func (k Kind) title() string {
+	switch k {
+	case Conflict:
+		return "already exists"
+	case Forbidden:
+		return "permission denied"
+	case NotFound:
+		return "not found"
+	case Unauthorized:
+		return "authentication failed"
+	case UnprocessableEntity:
+		return "invalid request"
+	default:
+		return "unexpected error"
+	}
+}
+
+// hint returns the remediation hint for the kind.
+func (k Kind) hint(permission string) string {
+	switch k {
+	case Conflict:
+		return "An object with the same unique name or key already exists in InfluxDB. " +
+			"Choose a different name, or import the existing object into Terraform with terraform import."
+	case Forbidden:
+		if permission == "" {
+			return "The token used by the provider is not allowed to perform this operation. " +
+				"Configure the provider with a token that has the required permission."
+		}
+
+		return fmt.Sprintf("The token used by the provider lacks the %q permission. "+
+			"Grant this permission to the token, or configure the provider with a token that has it.", permission)
+	case NotFound:
+		return "The object, or an object it references such as its organization, does not exist in InfluxDB. " +
+			"Check that the configured IDs are correct."
+	case Unauthorized:
+		return "InfluxDB rejected the provider credentials. " +
+			"Check that the configured token, or username and password, are valid and that the token has not been deleted or deactivated."
+	case UnprocessableEntity:
+		return "InfluxDB could not process the request. " +
+			"Check the configured attribute values against the constraints documented for this resource."
+	default:
+		return ""
+	}
+}
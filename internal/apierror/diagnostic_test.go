@@ -0,0 +1,76 @@
+package apierror
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+func TestDiagnostic(t *testing.T) {
+	permission := Permission(domain.PermissionActionWrite, "0123456789abcdef", domain.ResourceTypeBuckets)
+
+	tests := map[string]struct {
+		err         error
+		detail      string
+		wantSummary string
+		wantDetail  []string
+	}{
+		"unknown": {
+			err:         errors.New("connection refused"),
+			detail:      "Could not create bucket",
+			wantSummary: "Error creating bucket",
+			wantDetail:  []string{"Could not create bucket, unexpected error: connection refused"},
+		},
+		"unknown without detail": {
+			err:         errors.New("connection refused"),
+			wantSummary: "Error creating bucket",
+			wantDetail:  []string{"connection refused"},
+		},
+		"forbidden": {
+			err:         &http.Error{StatusCode: 403, Code: "forbidden", Message: "insufficient permissions"},
+			detail:      "Could not create bucket",
+			wantSummary: "Error creating bucket: permission denied",
+			wantDetail: []string{
+				"Could not create bucket: forbidden: insufficient permissions",
+				`lacks the "write:orgs/0123456789abcdef/buckets" permission`,
+			},
+		},
+		"conflict": {
+			err:         errors.New("conflict: bucket with name test already exists"),
+			detail:      "Could not create bucket",
+			wantSummary: "Error creating bucket: already exists",
+			wantDetail:  []string{"terraform import"},
+		},
+		"unauthorized": {
+			err:         &http.Error{StatusCode: 401, Code: "unauthorized", Message: "unauthorized access"},
+			wantSummary: "Error creating bucket: authentication failed",
+			wantDetail:  []string{"unauthorized: unauthorized access\n\n", "rejected the provider credentials"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diagnostic := Diagnostic("Error creating bucket", test.detail, test.err, permission)
+			if got := diagnostic.Summary(); got != test.wantSummary {
+				t.Errorf("summary = %q, want %q", got, test.wantSummary)
+			}
+			for _, want := range test.wantDetail {
+				if !strings.Contains(diagnostic.Detail(), want) {
+					t.Errorf("detail %q does not contain %q", diagnostic.Detail(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestPermission(t *testing.T) {
+	if got, want := Permission(domain.PermissionActionRead, "", domain.ResourceTypeUsers), "read:users"; got != want {
+		t.Errorf("Permission() = %q, want %q", got, want)
+	}
+	if got, want := Permission(domain.PermissionActionWrite, "abc", domain.ResourceTypeTasks), "write:orgs/abc/tasks"; got != want {
+		t.Errorf("Permission() = %q, want %q", got, want)
+	}
+}
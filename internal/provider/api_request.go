@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// doAPIRequest sends a JSON request to the InfluxDB v2 API through the client's HTTP service
+// and decodes the JSON response into result. It is used for endpoints the generated domain
+// client does not cover or cannot (de)serialize. apiPath is relative to the /api/v2/ root.
+// body and result may be nil.
+func doAPIRequest(ctx context.Context, client influxdb2.Client, method string, apiPath string, body any, result any) error {
+	return doJSONRequest(ctx, client, method, client.HTTPService().ServerAPIURL()+apiPath, body, result)
+}
+
+// doLegacyAPIRequest is like doAPIRequest for the v1 compatibility endpoints.
+// legacyPath is relative to the /private/legacy/ root.
+func doLegacyAPIRequest(ctx context.Context, client influxdb2.Client, method string, legacyPath string, body any, result any) error {
+	return doJSONRequest(ctx, client, method, client.HTTPService().ServerURL()+"private/legacy/"+legacyPath, body, result)
+}
+
+// doJSONRequest sends a JSON request to url and decodes the JSON response into result.
+func doJSONRequest(ctx context.Context, client influxdb2.Client, method string, url string, body any, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := nethttp.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+
+	httpErr := client.HTTPService().DoHTTPRequest(req, func(req *nethttp.Request) {
+		req.Header.Set("Accept", "application/json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+	}, func(resp *nethttp.Response) error {
+		defer resp.Body.Close()
+		if result == nil || resp.StatusCode == nethttp.StatusNoContent {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			return nil
+		}
+
+		return json.NewDecoder(resp.Body).Decode(result)
+	})
+	if httpErr != nil {
+		return httpErr
+	}
+
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -138,6 +140,8 @@ func (d *AuthorizationDataSource) Configure(ctx context.Context, req datasource.
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_authorization")...)
+
 	d.client = client
 }
 
@@ -152,10 +156,12 @@ func (d *AuthorizationDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	readAuthorization, err := d.client.AuthorizationsAPI().GetAuthorizations(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error getting Authorizations",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
 
 		return
 	}
@@ -1,6 +1,9 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // AuthorizationModel maps InfluxDB authorization schema data.
 type AuthorizationModel struct {
@@ -17,6 +20,12 @@ type AuthorizationModel struct {
 	Permissions []AuthorizationPermissionModel `tfsdk:"permissions"`
 }
 
+// AuthorizationResourceModel maps the authorization resource schema data, adding the timeouts to AuthorizationModel.
+type AuthorizationResourceModel struct {
+	AuthorizationModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // AuthorizationPermissionModel maps InfluxDB authorization permission schema data.
 type AuthorizationPermissionModel struct {
 	Action   types.String                         `tfsdk:"action"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -23,6 +26,7 @@ var (
 	_ resource.Resource                = &AuthorizationResource{}
 	_ resource.ResourceWithImportState = &AuthorizationResource{}
 	_ resource.ResourceWithImportState = &AuthorizationResource{}
+	_ resource.ResourceWithModifyPlan  = &AuthorizationResource{}
 )
 
 // NewAuthorizationResource is a helper function to simplify the provider implementation.
@@ -80,8 +84,12 @@ func (r *AuthorizationResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"org_id": schema.StringAttribute{
-				Required:    true,
-				Description: "An organization ID. Specifies the organization that owns the authorization.",
+				Computed:    true,
+				Optional:    true,
+				Description: "An organization ID. Specifies the organization that owns the authorization. Defaults to the default organization of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"org": schema.StringAttribute{
 				Computed:    true,
@@ -188,12 +196,21 @@ func (r *AuthorizationResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
+// ModifyPlan defaults org_id to the default organization of the provider.
+func (r *AuthorizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan AuthorizationModel
+	var plan AuthorizationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -201,6 +218,15 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var permissions []domain.Permission
 	for _, permissionData := range plan.Permissions {
@@ -230,10 +256,12 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 
 	apiResponse, err := r.client.AuthorizationsAPI().CreateAuthorization(ctx, &createAuthorization)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error creating authorization",
-			"Could not create authorization, unexpected error: "+err.Error(),
-		)
+			"Could not create authorization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
 
 		return
 	}
@@ -258,7 +286,7 @@ func (r *AuthorizationResource) Create(ctx context.Context, req resource.CreateR
 // Read refreshes the Terraform state with the latest data.
 func (r *AuthorizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state AuthorizationModel
+	var state AuthorizationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -266,13 +294,24 @@ func (r *AuthorizationResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed authorization value from InfluxDB
 	readAuthorization, err := r.client.AuthorizationsAPI().GetAuthorizations(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error getting Authorizations",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
 
 		return
 	}
@@ -286,12 +325,9 @@ func (r *AuthorizationResource) Read(ctx context.Context, req resource.ReadReque
 		}
 	}
 
+	// The authorization was removed outside of Terraform
 	if authorization == nil {
-		resp.Diagnostics.AddError(
-			"Authorization not found",
-			"Authorization not found",
-		)
-
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -314,7 +350,7 @@ func (r *AuthorizationResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan AuthorizationModel
+	var plan AuthorizationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -322,6 +358,15 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	var status domain.AuthorizationUpdateRequestStatus
 	if plan.Status.ValueString() == "active" {
@@ -333,10 +378,12 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 	// Update existing authorization
 	apiResponse, err := r.client.AuthorizationsAPI().UpdateAuthorizationStatusWithID(ctx, *plan.Id.ValueStringPointer(), status)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error updating authorization",
-			"Could not update authorization, unexpected error: "+err.Error(),
-		)
+			"Could not update authorization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
 
 		return
 	}
@@ -360,7 +407,7 @@ func (r *AuthorizationResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state AuthorizationModel
+	var state AuthorizationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -368,13 +415,24 @@ func (r *AuthorizationResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing authorization
 	err := r.client.AuthorizationsAPI().DeleteAuthorizationWithID(ctx, *state.Id.ValueStringPointer())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error deleting authorization",
-			"Could not delete authorization, unexpected error: "+err.Error(),
-		)
+			"Could not delete authorization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
 
 		return
 	}
@@ -397,6 +455,8 @@ func (r *AuthorizationResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_authorization")...)
+
 	r.client = client
 }
 
@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -149,6 +152,8 @@ func (d *AuthorizationsDataSource) Configure(ctx context.Context, req datasource
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_authorizations")...)
+
 	d.client = client
 }
 
@@ -163,10 +168,12 @@ func (d *AuthorizationsDataSource) Read(ctx context.Context, req datasource.Read
 
 	readAuthorizations, err := d.client.AuthorizationsAPI().GetAuthorizations(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error getting Authorizationss",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeAuthorizations),
+		))
 
 		return
 	}
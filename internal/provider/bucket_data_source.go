@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -58,6 +61,11 @@ func (d *BucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Required:    true,
 				Description: "A Bucket name.",
 			},
+			"label_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the labels attached to the bucket.",
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Bucket creation date.",
@@ -91,6 +99,8 @@ func (d *BucketDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_bucket")...)
+
 	d.client = client
 }
 
@@ -115,25 +125,18 @@ func (d *BucketDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	bucket, err := d.client.BucketsAPI().FindBucketByName(ctx, bucketName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Bucket not found",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading bucket",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeBuckets),
+		))
 
 		return
 	}
 
 	// Map response body to model
-	state = BucketModel{
-		Id:              types.StringPointerValue(bucket.Id),
-		OrgID:           types.StringPointerValue(bucket.OrgID),
-		Type:            types.StringValue(string(*bucket.Type)),
-		Description:     types.StringPointerValue(bucket.Description),
-		Name:            types.StringValue(bucket.Name),
-		CreatedAt:       types.StringValue(bucket.CreatedAt.String()),
-		UpdatedAt:       types.StringValue(bucket.UpdatedAt.String()),
-		RetentionPeriod: types.Int64Value(bucket.RetentionRules[0].EverySeconds),
-	}
+	state = convertDomainBucketToModel(ctx, bucket)
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
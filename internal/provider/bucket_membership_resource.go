@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BucketMembershipResource{}
+	_ resource.ResourceWithImportState = &BucketMembershipResource{}
+)
+
+// NewBucketMemberResource is a helper function to simplify the provider implementation.
+func NewBucketMemberResource() resource.Resource {
+	return newBucketMembershipResource("member")
+}
+
+// NewBucketOwnerResource is a helper function to simplify the provider implementation.
+func NewBucketOwnerResource() resource.Resource {
+	return newBucketMembershipResource("owner")
+}
+
+// newBucketMembershipResource returns the bucket membership resource of role.
+func newBucketMembershipResource(role string) *BucketMembershipResource {
+	return &BucketMembershipResource{
+		membershipResource: membershipResource{
+			role:               role,
+			target:             bucketMembershipTarget{},
+			targetAttribute:    "bucket_id",
+			targetName:         "bucket",
+			targetResourceType: domain.ResourceTypeBuckets,
+			typeName:           "bucket_" + role,
+		},
+	}
+}
+
+// BucketMembershipResource defines the resource implementation.
+// It manages a single member or owner edge between a user and a bucket.
+type BucketMembershipResource struct {
+	membershipResource
+}
+
+// Metadata returns the resource type name.
+func (r *BucketMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *BucketMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("Adds a user as %s of a bucket. The user does not need to be managed by Terraform. Existing %ss are imported using `<bucket_id>/<user_id>`.", roleWithArticle(r.role), r.role),
+
+		Attributes: map[string]schema.Attribute{
+			"bucket_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The bucket ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID in the form `<bucket_id>/<user_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The ID of the user to add as %s.", r.role),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// bucketMembershipTarget adds, lists and removes the members and owners of a bucket.
+type bucketMembershipTarget struct{}
+
+// addUser adds the user to the bucket in the role
+func (bucketMembershipTarget) addUser(ctx context.Context, client influxdb2.Client, role string, bucketID string, userID string) (*domain.UserResponse, error) {
+	if role == "owner" {
+		owner, err := client.BucketsAPI().AddOwnerWithID(ctx, bucketID, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &owner.UserResponse, nil
+	}
+
+	member, err := client.BucketsAPI().AddMemberWithID(ctx, bucketID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &member.UserResponse, nil
+}
+
+// listUsers returns the users of the bucket in the role
+func (bucketMembershipTarget) listUsers(ctx context.Context, client influxdb2.Client, role string, bucketID string) ([]domain.UserResponse, error) {
+	if role == "owner" {
+		owners, err := client.BucketsAPI().GetOwnersWithID(ctx, bucketID)
+		if err != nil {
+			return nil, err
+		}
+		return resourceOwnersToUsers(owners), nil
+	}
+
+	members, err := client.BucketsAPI().GetMembersWithID(ctx, bucketID)
+	if err != nil {
+		return nil, err
+	}
+	return resourceMembersToUsers(members), nil
+}
+
+// removeUser removes the user from the bucket in the role
+func (bucketMembershipTarget) removeUser(ctx context.Context, client influxdb2.Client, role string, bucketID string, userID string) error {
+	if role == "owner" {
+		return client.BucketsAPI().RemoveOwnerWithID(ctx, bucketID, userID)
+	}
+	return client.BucketsAPI().RemoveMemberWithID(ctx, bucketID, userID)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBucketMemberResource(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tf-bucket-member-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccBucketMembershipResourceConfig("member", userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("influxdb_bucket_member.test", "bucket_id", "influxdb_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair("influxdb_bucket_member.test", "user_id", "influxdb_user.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_bucket_member.test", "user_name", userName),
+					resource.TestCheckResourceAttrSet("influxdb_bucket_member.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_bucket_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBucketOwnerResource(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tf-bucket-owner-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccBucketMembershipResourceConfig("owner", userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("influxdb_bucket_owner.test", "bucket_id", "influxdb_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair("influxdb_bucket_owner.test", "user_id", "influxdb_user.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_bucket_owner.test", "user_name", userName),
+					resource.TestCheckResourceAttrSet("influxdb_bucket_owner.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_bucket_owner.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBucketMembershipResourceConfig(role string, userName string) string {
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "test" {
+  name   = %[2]q
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_user" "test" {
+  name     = %[2]q
+  password = %[3]q
+}
+
+resource "influxdb_bucket_%[1]s" "test" {
+  bucket_id = influxdb_bucket.test.id
+  user_id   = influxdb_user.test.id
+}
+`, role, userName, acctest.RandomWithPrefix("password"))
+}
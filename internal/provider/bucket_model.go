@@ -1,6 +1,12 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
 
 // BucketModel maps InfluxDB bucket schema data.
 type BucketModel struct {
@@ -9,7 +15,31 @@ type BucketModel struct {
 	Type            types.String `tfsdk:"type"`
 	Description     types.String `tfsdk:"description"`
 	Name            types.String `tfsdk:"name"`
+	LabelIDs        types.Set    `tfsdk:"label_ids"`
 	CreatedAt       types.String `tfsdk:"created_at"`
 	UpdatedAt       types.String `tfsdk:"updated_at"`
 	RetentionPeriod types.Int64  `tfsdk:"retention_period"` // buckets cannot have more than one retention rule at this time
 }
+
+// BucketResourceModel maps the bucket resource schema data, adding the timeouts to BucketModel.
+type BucketResourceModel struct {
+	BucketModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// convertDomainBucketToModel converts a domain.Bucket to BucketModel
+func convertDomainBucketToModel(ctx context.Context, bucket *domain.Bucket) BucketModel {
+	labelIDs, _ := convertLabelsToIDSet(ctx, bucket.Labels)
+
+	return BucketModel{
+		Id:              types.StringPointerValue(bucket.Id),
+		OrgID:           types.StringPointerValue(bucket.OrgID),
+		Type:            types.StringValue(string(*bucket.Type)),
+		Description:     types.StringPointerValue(bucket.Description),
+		Name:            types.StringValue(bucket.Name),
+		LabelIDs:        labelIDs,
+		CreatedAt:       types.StringValue(bucket.CreatedAt.String()),
+		UpdatedAt:       types.StringValue(bucket.UpdatedAt.String()),
+		RetentionPeriod: types.Int64Value(bucket.RetentionRules[0].EverySeconds),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -22,6 +26,7 @@ var (
 	_ resource.Resource                = &BucketResource{}
 	_ resource.ResourceWithImportState = &BucketResource{}
 	_ resource.ResourceWithImportState = &BucketResource{}
+	_ resource.ResourceWithModifyPlan  = &BucketResource{}
 )
 
 // NewBucketResource is a helper function to simplify the provider implementation.
@@ -54,8 +59,12 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"org_id": schema.StringAttribute{
-				Required:    true,
-				Description: "An organization ID.",
+				Computed:    true,
+				Optional:    true,
+				Description: "An organization ID. Defaults to the default organization of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Computed:    true,
@@ -74,6 +83,14 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:    true,
 				Description: "A Bucket name.",
 			},
+			"label_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the labels attached to the bucket. When set, Terraform manages the full label set of the bucket and removes labels attached outside of Terraform.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Bucket creation date.",
@@ -89,12 +106,21 @@ func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "The duration in seconds for how long data will be kept in the database. The default duration is `2592000` (30 days). `0` represents infinite retention.",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
+// ModifyPlan defaults org_id to the default organization of the provider.
+func (r *BucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan BucketModel
+	var plan BucketResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -102,6 +128,15 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	createBucket := domain.Bucket{
 		OrgID:       plan.OrgID.ValueStringPointer(),
@@ -114,26 +149,43 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	apiResponse, err := r.client.BucketsAPI().CreateBucket(ctx, &createBucket)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error creating bucket",
-			"Could not create bucket, unexpected error: "+err.Error(),
-		)
+			"Could not create bucket",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeBuckets),
+		))
 
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.Id = types.StringPointerValue(apiResponse.Id)
-	plan.OrgID = types.StringPointerValue(apiResponse.OrgID)
-	plan.Name = types.StringValue(apiResponse.Name)
-	plan.Type = types.StringValue(string(*apiResponse.Type))
-	plan.Description = types.StringPointerValue(apiResponse.Description)
-	plan.CreatedAt = types.StringValue(apiResponse.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(apiResponse.UpdatedAt.String())
-	plan.RetentionPeriod = types.Int64Value(apiResponse.RetentionRules[0].EverySeconds)
+	state := BucketResourceModel{
+		BucketModel: convertDomainBucketToModel(ctx, apiResponse),
+		Timeouts:    plan.Timeouts,
+	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.updateLabels(ctx, state.Id.ValueString(), types.SetNull(types.StringType), plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error adding labels to bucket",
+			"Could not add labels to bucket",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeBuckets),
+		))
+
+		return
+	}
+	state.LabelIDs = plan.LabelIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -142,7 +194,7 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state BucketModel
+	var state BucketResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -150,26 +202,37 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed bucket value from InfluxDB
 	readBucket, err := r.client.BucketsAPI().FindBucketByID(ctx, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Bucket not found",
-			err.Error(),
-		)
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading bucket",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeBuckets),
+		))
 
 		return
 	}
 
 	// Overwrite items with refreshed state
-	state.Id = types.StringPointerValue(readBucket.Id)
-	state.OrgID = types.StringPointerValue(readBucket.OrgID)
-	state.Name = types.StringValue(readBucket.Name)
-	state.Type = types.StringValue(string(*readBucket.Type))
-	state.Description = types.StringPointerValue(readBucket.Description)
-	state.CreatedAt = types.StringValue(readBucket.CreatedAt.String())
-	state.UpdatedAt = types.StringValue(readBucket.UpdatedAt.String())
-	state.RetentionPeriod = types.Int64Value(readBucket.RetentionRules[0].EverySeconds)
+	labelIDs := state.LabelIDs
+	state.BucketModel = convertDomainBucketToModel(ctx, readBucket)
+	state.LabelIDs = refreshedLabelIDs(labelIDs, state.LabelIDs)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -180,7 +243,8 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan BucketModel
+	var plan BucketResourceModel
+	var state BucketResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -188,6 +252,21 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
 	updateBucket := domain.Bucket{
 		OrgID:       plan.OrgID.ValueStringPointer(),
@@ -202,26 +281,37 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update existing bucket
 	apiResponse, err := r.client.BucketsAPI().UpdateBucket(ctx, &updateBucket)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error updating bucket",
-			"Could not update bucket, unexpected error: "+err.Error(),
-		)
+			"Could not update bucket",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeBuckets),
+		))
+
+		return
+	}
+
+	err = r.updateLabels(ctx, state.Id.ValueString(), state.LabelIDs, plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating bucket labels",
+			"Could not update bucket labels",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeBuckets),
+		))
 
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan.Id = types.StringPointerValue(apiResponse.Id)
-	plan.OrgID = types.StringPointerValue(apiResponse.OrgID)
-	plan.Name = types.StringValue(apiResponse.Name)
-	plan.Type = types.StringValue(string(*apiResponse.Type))
-	plan.Description = types.StringPointerValue(apiResponse.Description)
-	plan.CreatedAt = types.StringValue(apiResponse.CreatedAt.String())
-	plan.UpdatedAt = types.StringValue(apiResponse.UpdatedAt.String())
-	plan.RetentionPeriod = types.Int64Value(apiResponse.RetentionRules[0].EverySeconds)
+	newState := BucketResourceModel{
+		BucketModel: convertDomainBucketToModel(ctx, apiResponse),
+		Timeouts:    plan.Timeouts,
+	}
+	newState.LabelIDs = plan.LabelIDs
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -229,7 +319,7 @@ func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state BucketModel
+	var state BucketResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -237,13 +327,24 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing bucket
 	err := r.client.BucketsAPI().DeleteBucketWithID(ctx, *state.Id.ValueStringPointer())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error deleting bucket",
-			"Could not delete bucket, unexpected error: "+err.Error(),
-		)
+			"Could not delete bucket",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeBuckets),
+		))
 
 		return
 	}
@@ -266,9 +367,49 @@ func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_bucket")...)
+
 	r.client = client
 }
 
 func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// updateLabels attaches and detaches labels so the bucket carries exactly the desired label IDs
+func (r *BucketResource) updateLabels(ctx context.Context, bucketID string, current types.Set, desired types.Set) error {
+	var currentIDs, desiredIDs []string
+	if !current.IsNull() && !current.IsUnknown() {
+		if diags := current.ElementsAs(ctx, &currentIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read current label IDs")
+		}
+	}
+	if !desired.IsNull() && !desired.IsUnknown() {
+		if diags := desired.ElementsAs(ctx, &desiredIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read desired label IDs")
+		}
+	}
+
+	toAdd, toRemove := diffIDs(currentIDs, desiredIDs)
+	for _, labelID := range toAdd {
+		_, err := r.client.APIClient().PostBucketsIDLabels(ctx, &domain.PostBucketsIDLabelsAllParams{
+			BucketID: bucketID,
+			Body:     domain.PostBucketsIDLabelsJSONRequestBody{LabelID: &labelID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add label %s: %w", labelID, err)
+		}
+	}
+
+	for _, labelID := range toRemove {
+		err := r.client.APIClient().DeleteBucketsIDLabelsID(ctx, &domain.DeleteBucketsIDLabelsIDAllParams{
+			BucketID: bucketID,
+			LabelID:  labelID,
+		})
+		if err != nil && !apierror.IsNotFound(err) {
+			return fmt.Errorf("failed to remove label %s: %w", labelID, err)
+		}
+	}
+
+	return nil
+}
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccBucketResource(t *testing.T) {
@@ -41,6 +44,72 @@ func TestAccBucketResource(t *testing.T) {
 	})
 }
 
+func TestAccBucketResourceDisappears(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the bucket outside of Terraform and expect it to be recreated
+			{
+				Config: providerConfig + testAccBucketResourceConfig("test-disappears", "test bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketDisappears("influxdb_bucket.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckBucketDisappears deletes the bucket of resourceName directly in InfluxDB.
+func testAccCheckBucketDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		client := testAccClient()
+		defer client.Close()
+
+		return client.BucketsAPI().DeleteBucketWithID(context.Background(), rs.Primary.ID)
+	}
+}
+
+func TestAccBucketResourceDefaultOrg(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Plan without org_id and without a default organization
+			{
+				Config: `
+provider "influxdb" {
+  org_id = ""
+}
+` + testAccBucketResourceDefaultOrgConfig("test-default-org"),
+				ExpectError: regexp.MustCompile(`Missing Organization ID`),
+			},
+			// Create with the default organization of INFLUXDB_ORG_ID
+			{
+				Config: providerConfig + testAccBucketResourceDefaultOrgConfig("test-default-org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "name", "test-default-org"),
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+				),
+			},
+		},
+	})
+}
+
+func testAccBucketResourceDefaultOrgConfig(name string) string {
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "test" {
+  name = %[1]q
+}
+`, name)
+}
+
 func testAccBucketResourceWithRetentionConfig(name string, description string, retention_period string) string {
 	return fmt.Sprintf(`
 resource "influxdb_bucket" "test" {
@@ -61,3 +130,122 @@ resource "influxdb_bucket" "test" {
 }
 `, name, description)
 }
+
+func TestAccBucketResourceLabelAssignment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A bucket without label_ids leaves labels attached with influxdb_label_assignment alone
+			{
+				Config: providerConfig + testAccLabelAssignmentResourceConfig("test-bucket-label-assignment"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("influxdb_bucket.test", "label_ids.#"),
+					resource.TestCheckResourceAttrPair("influxdb_label_assignment.test", "resource_id", "influxdb_bucket.test", "id"),
+				),
+			},
+			// Refreshing the bucket does not take over the assigned label
+			{
+				Config:   providerConfig + testAccLabelAssignmentResourceConfig("test-bucket-label-assignment"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBucketResourceLabels(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccBucketResourceLabelsConfig("[influxdb_label.first.id, influxdb_label.second.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "label_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_bucket.test", "label_ids.*", "influxdb_label.first", "id"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_bucket.test", "label_ids.*", "influxdb_label.second", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_bucket.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccBucketResourceLabelsConfig("[influxdb_label.second.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "label_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_bucket.test", "label_ids.*", "influxdb_label.second", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBucketResourceLabelsConfig(labelIDs string) string {
+	return `
+resource "influxdb_label" "first" {
+  name   = "test-bucket-label-first"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+}
+
+resource "influxdb_label" "second" {
+  name   = "test-bucket-label-second"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+}
+
+resource "influxdb_bucket" "test" {
+  name      = "test-bucket-labels"
+  org_id    = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  label_ids = ` + labelIDs + `
+}
+`
+}
+
+func TestAccBucketResourceTimeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccBucketResourceTimeoutsConfig("1m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "timeouts.create", "1m"),
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "timeouts.update", "1m"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccBucketResourceTimeoutsConfig("2m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "timeouts.create", "2m"),
+					resource.TestCheckResourceAttr("influxdb_bucket.test", "timeouts.update", "2m"),
+				),
+			},
+			// Invalid timeouts
+			{
+				Config:      providerConfig + testAccBucketResourceTimeoutsConfig("soon"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+			},
+		},
+	})
+}
+
+func testAccBucketResourceTimeoutsConfig(timeout string) string {
+	return `
+resource "influxdb_bucket" "test" {
+  name   = "test-bucket-timeouts"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+
+  timeouts {
+    create = "` + timeout + `"
+    update = "` + timeout + `"
+  }
+}
+`
+}
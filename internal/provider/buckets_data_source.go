@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -67,6 +70,11 @@ func (d *BucketsDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							Computed:    true,
 							Description: "A Bucket name.",
 						},
+						"label_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the labels attached to the bucket.",
+						},
 						"created_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "Bucket creation date.",
@@ -103,6 +111,8 @@ func (d *BucketsDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_buckets")...)
+
 	d.client = client
 }
 
@@ -112,26 +122,19 @@ func (d *BucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	buckets, err := d.client.BucketsAPI().GetBuckets(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Unable to list buckets",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeBuckets),
+		))
 
 		return
 	}
 
 	// Map response body to model
 	for _, bucket := range *buckets {
-		bucketState := BucketModel{
-			Id:              types.StringValue(*bucket.Id),
-			OrgID:           types.StringValue(*bucket.OrgID),
-			Type:            types.StringValue(string(*bucket.Type)),
-			Description:     types.StringPointerValue(bucket.Description),
-			Name:            types.StringValue(bucket.Name),
-			CreatedAt:       types.StringValue(bucket.CreatedAt.String()),
-			UpdatedAt:       types.StringValue(bucket.UpdatedAt.String()),
-			RetentionPeriod: types.Int64Value(bucket.RetentionRules[0].EverySeconds),
-		}
+		bucketState := convertDomainBucketToModel(ctx, &bucket)
 
 		state.Buckets = append(state.Buckets, bucketState)
 	}
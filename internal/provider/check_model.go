@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// CheckModel maps InfluxDB check schema data.
+type CheckModel struct {
+	CreatedAt             types.String          `tfsdk:"created_at"`
+	Deadman               *CheckDeadmanModel    `tfsdk:"deadman"`
+	Description           types.String          `tfsdk:"description"`
+	Every                 types.String          `tfsdk:"every"`
+	Id                    types.String          `tfsdk:"id"`
+	LabelIDs              types.Set             `tfsdk:"label_ids"`
+	LastRunError          types.String          `tfsdk:"last_run_error"`
+	LastRunStatus         types.String          `tfsdk:"last_run_status"`
+	Name                  types.String          `tfsdk:"name"`
+	Offset                types.String          `tfsdk:"offset"`
+	OrgID                 types.String          `tfsdk:"org_id"`
+	OwnerID               types.String          `tfsdk:"owner_id"`
+	Query                 CheckQueryModel       `tfsdk:"query"`
+	Status                types.String          `tfsdk:"status"`
+	StatusMessageTemplate types.String          `tfsdk:"status_message_template"`
+	Tags                  types.Map             `tfsdk:"tags"`
+	TaskID                types.String          `tfsdk:"task_id"`
+	Thresholds            []CheckThresholdModel `tfsdk:"thresholds"`
+	Timeouts              timeouts.Value        `tfsdk:"timeouts"`
+	Type                  types.String          `tfsdk:"type"`
+	UpdatedAt             types.String          `tfsdk:"updated_at"`
+}
+
+// CheckQueryModel maps InfluxDB check query schema data.
+type CheckQueryModel struct {
+	EditMode types.String `tfsdk:"edit_mode"`
+	Text     types.String `tfsdk:"text"`
+}
+
+// CheckThresholdModel maps InfluxDB threshold check threshold schema data.
+type CheckThresholdModel struct {
+	AllValues types.Bool    `tfsdk:"all_values"`
+	Level     types.String  `tfsdk:"level"`
+	Max       types.Float64 `tfsdk:"max"`
+	Min       types.Float64 `tfsdk:"min"`
+	Type      types.String  `tfsdk:"type"`
+	Value     types.Float64 `tfsdk:"value"`
+	Within    types.Bool    `tfsdk:"within"`
+}
+
+// CheckDeadmanModel maps InfluxDB deadman check schema data.
+type CheckDeadmanModel struct {
+	Level      types.String `tfsdk:"level"`
+	ReportZero types.Bool   `tfsdk:"report_zero"`
+	StaleTime  types.String `tfsdk:"stale_time"`
+	TimeSince  types.String `tfsdk:"time_since"`
+}
+
+// checkStatusLevels lists the status levels a check can record.
+var checkStatusLevels = []string{"CRIT", "WARN", "INFO", "OK", "UNKNOWN"}
+
+// convertModelToDomainCheck converts a CheckModel to the domain.Check matching its type.
+func convertModelToDomainCheck(ctx context.Context, plan CheckModel) (domain.Check, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	editMode := domain.QueryEditMode(plan.Query.EditMode.ValueString())
+	base := domain.CheckBaseExtend{
+		CheckBase: domain.CheckBase{
+			Description: plan.Description.ValueStringPointer(),
+			Name:        plan.Name.ValueString(),
+			OrgID:       plan.OrgID.ValueString(),
+			Query: domain.DashboardQuery{
+				EditMode: &editMode,
+				Text:     plan.Query.Text.ValueStringPointer(),
+			},
+			Status: domain.TaskStatusType(plan.Status.ValueString()),
+		},
+		Every:                 plan.Every.ValueStringPointer(),
+		StatusMessageTemplate: plan.StatusMessageTemplate.ValueStringPointer(),
+	}
+
+	if !plan.Offset.IsNull() && !plan.Offset.IsUnknown() {
+		base.Offset = plan.Offset.ValueStringPointer()
+	}
+
+	// Convert tags map to the key/value list used by the API
+	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
+		tagsMap := make(map[string]string)
+		diags.Append(plan.Tags.ElementsAs(ctx, &tagsMap, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tags := make([]struct {
+			Key   *string `json:"key,omitempty"`
+			Value *string `json:"value,omitempty"`
+		}, 0, len(tagsMap))
+		for key, value := range tagsMap {
+			tags = append(tags, struct {
+				Key   *string `json:"key,omitempty"`
+				Value *string `json:"value,omitempty"`
+			}{Key: &key, Value: &value})
+		}
+		base.Tags = &tags
+	}
+
+	switch plan.Type.ValueString() {
+	case string(domain.ThresholdCheckTypeThreshold):
+		thresholds := make([]domain.Threshold, 0, len(plan.Thresholds))
+		for _, threshold := range plan.Thresholds {
+			level := domain.CheckStatusLevel(threshold.Level.ValueString())
+			thresholdBase := domain.ThresholdBase{
+				AllValues: threshold.AllValues.ValueBoolPointer(),
+				Level:     &level,
+			}
+
+			switch threshold.Type.ValueString() {
+			case string(domain.GreaterThresholdTypeGreater):
+				thresholds = append(thresholds, &domain.GreaterThreshold{
+					ThresholdBase: thresholdBase,
+					Value:         float32(threshold.Value.ValueFloat64()),
+				})
+			case string(domain.LesserThresholdTypeLesser):
+				thresholds = append(thresholds, &domain.LesserThreshold{
+					ThresholdBase: thresholdBase,
+					Value:         float32(threshold.Value.ValueFloat64()),
+				})
+			case string(domain.RangeThresholdTypeRange):
+				thresholds = append(thresholds, &domain.RangeThreshold{
+					ThresholdBase: thresholdBase,
+					Max:           float32(threshold.Max.ValueFloat64()),
+					Min:           float32(threshold.Min.ValueFloat64()),
+					Within:        threshold.Within.ValueBool(),
+				})
+			}
+		}
+
+		return &domain.ThresholdCheck{
+			CheckBaseExtend: base,
+			Thresholds:      &thresholds,
+		}, diags
+	case string(domain.DeadmanCheckTypeDeadman):
+		check := &domain.DeadmanCheck{
+			CheckBaseExtend: base,
+		}
+		if plan.Deadman != nil {
+			level := domain.CheckStatusLevel(plan.Deadman.Level.ValueString())
+			check.Level = &level
+			check.ReportZero = plan.Deadman.ReportZero.ValueBoolPointer()
+			check.TimeSince = plan.Deadman.TimeSince.ValueStringPointer()
+			if !plan.Deadman.StaleTime.IsNull() && !plan.Deadman.StaleTime.IsUnknown() {
+				check.StaleTime = plan.Deadman.StaleTime.ValueStringPointer()
+			}
+		}
+
+		return check, diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("type"),
+		"Unsupported check type",
+		fmt.Sprintf("Check type %q is not supported.", plan.Type.ValueString()),
+	)
+
+	return nil, diags
+}
+
+// convertDomainCheckToModel converts a domain.Check to CheckModel.
+func convertDomainCheckToModel(ctx context.Context, check domain.Check) (CheckModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var base domain.CheckBaseExtend
+	var model CheckModel
+
+	switch c := check.(type) {
+	case *domain.ThresholdCheck:
+		base = c.CheckBaseExtend
+		model.Type = types.StringValue(string(domain.ThresholdCheckTypeThreshold))
+		if c.Thresholds != nil {
+			model.Thresholds = make([]CheckThresholdModel, 0, len(*c.Thresholds))
+			for _, threshold := range *c.Thresholds {
+				model.Thresholds = append(model.Thresholds, convertDomainThresholdToModel(threshold))
+			}
+		}
+	case *domain.DeadmanCheck:
+		base = c.CheckBaseExtend
+		model.Type = types.StringValue(string(domain.DeadmanCheckTypeDeadman))
+		model.Deadman = &CheckDeadmanModel{
+			Level:      convertCheckStatusLevelToString(c.Level),
+			ReportZero: types.BoolValue(c.ReportZero != nil && *c.ReportZero),
+			StaleTime:  types.StringPointerValue(c.StaleTime),
+			TimeSince:  types.StringPointerValue(c.TimeSince),
+		}
+	default:
+		diags.AddError(
+			"Unsupported check type",
+			fmt.Sprintf("Check type %q is not supported by this resource.", check.Type()),
+		)
+		return model, diags
+	}
+
+	editMode := types.StringNull()
+	if base.Query.EditMode != nil {
+		editMode = types.StringValue(string(*base.Query.EditMode))
+	}
+
+	lastRunStatus := types.StringNull()
+	if base.LastRunStatus != nil {
+		lastRunStatus = types.StringValue(string(*base.LastRunStatus))
+	}
+
+	tags := types.MapNull(types.StringType)
+	if base.Tags != nil && len(*base.Tags) > 0 {
+		tagsMap := make(map[string]string, len(*base.Tags))
+		for _, tag := range *base.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tagsMap[*tag.Key] = *tag.Value
+			}
+		}
+		var tagDiags diag.Diagnostics
+		tags, tagDiags = types.MapValueFrom(ctx, types.StringType, tagsMap)
+		diags.Append(tagDiags...)
+	}
+
+	labelIDs, labelDiags := convertLabelsToIDSet(ctx, base.Labels)
+	diags.Append(labelDiags...)
+
+	model.CreatedAt = convertTimeToString(base.CreatedAt)
+	model.Description = types.StringPointerValue(base.Description)
+	model.Every = types.StringPointerValue(base.Every)
+	model.Id = types.StringPointerValue(base.Id)
+	model.LabelIDs = labelIDs
+	model.LastRunError = types.StringPointerValue(base.LastRunError)
+	model.LastRunStatus = lastRunStatus
+	model.Name = types.StringValue(base.Name)
+	model.Offset = types.StringPointerValue(base.Offset)
+	model.OrgID = types.StringValue(base.OrgID)
+	model.OwnerID = types.StringPointerValue(base.OwnerID)
+	model.Query = CheckQueryModel{
+		EditMode: editMode,
+		Text:     types.StringPointerValue(base.Query.Text),
+	}
+	model.Status = types.StringValue(string(base.Status))
+	model.StatusMessageTemplate = types.StringPointerValue(base.StatusMessageTemplate)
+	model.Tags = tags
+	model.TaskID = types.StringPointerValue(base.TaskID)
+	model.UpdatedAt = convertTimeToString(base.UpdatedAt)
+
+	return model, diags
+}
+
+// convertDomainThresholdToModel converts a domain.Threshold to CheckThresholdModel.
+func convertDomainThresholdToModel(threshold domain.Threshold) CheckThresholdModel {
+	model := CheckThresholdModel{
+		AllValues: types.BoolValue(false),
+		Max:       types.Float64Null(),
+		Min:       types.Float64Null(),
+		Type:      types.StringValue(threshold.Type()),
+		Value:     types.Float64Null(),
+		Within:    types.BoolValue(false),
+	}
+
+	var base domain.ThresholdBase
+	switch t := threshold.(type) {
+	case *domain.GreaterThreshold:
+		base = t.ThresholdBase
+		model.Value = types.Float64Value(convertFloat32ToFloat64(t.Value))
+	case *domain.LesserThreshold:
+		base = t.ThresholdBase
+		model.Value = types.Float64Value(convertFloat32ToFloat64(t.Value))
+	case *domain.RangeThreshold:
+		base = t.ThresholdBase
+		model.Max = types.Float64Value(convertFloat32ToFloat64(t.Max))
+		model.Min = types.Float64Value(convertFloat32ToFloat64(t.Min))
+		model.Within = types.BoolValue(t.Within)
+	}
+
+	if base.AllValues != nil {
+		model.AllValues = types.BoolValue(*base.AllValues)
+	}
+	model.Level = convertCheckStatusLevelToString(base.Level)
+
+	return model
+}
+
+// Helper function to convert CheckStatusLevel to string
+func convertCheckStatusLevelToString(level *domain.CheckStatusLevel) types.String {
+	if level != nil {
+		return types.StringValue(string(*level))
+	}
+	return types.StringNull()
+}
+
+// Helper function to convert a float32 API value to the float64 used in the schema.
+// The value is formatted with float32 precision first so that e.g. 80.1 does not
+// come back as 80.09999847412109.
+func convertFloat32ToFloat64(value float32) float64 {
+	converted, err := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'g', -1, 32), 64)
+	if err != nil {
+		return float64(value)
+	}
+	return converted
+}
@@ -0,0 +1,653 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &CheckResource{}
+	_ resource.ResourceWithImportState    = &CheckResource{}
+	_ resource.ResourceWithValidateConfig = &CheckResource{}
+)
+
+// NewCheckResource is a helper function to simplify the provider implementation.
+func NewCheckResource() resource.Resource {
+	return &CheckResource{}
+}
+
+// CheckResource defines the resource implementation.
+type CheckResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *CheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check"
+}
+
+// Schema defines the schema for the resource.
+func (r *CheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a threshold or deadman check. A check queries data on a schedule and writes a status to the `_monitoring` bucket that notification rules act on.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the check was created.",
+			},
+			"deadman": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The deadman settings. Required when `type` is `deadman`.",
+				Attributes: map[string]schema.Attribute{
+					"level": schema.StringAttribute{
+						Required:    true,
+						Description: "The status level to record when the deadman triggers. Valid values are `CRIT`, `WARN`, `INFO`, `OK` or `UNKNOWN`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(checkStatusLevels...),
+						},
+					},
+					"report_zero": schema.BoolAttribute{
+						Computed:    true,
+						Optional:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Trigger the deadman if only zero values were reported since `time_since`. Default: `false`.",
+					},
+					"stale_time": schema.StringAttribute{
+						Optional:    true,
+						Description: "The [duration](https://docs.influxdata.com/influxdb/v2/reference/glossary/#duration) after which a series is considered stale and no longer triggers the deadman.",
+					},
+					"time_since": schema.StringAttribute{
+						Required:    true,
+						Description: "The [duration](https://docs.influxdata.com/influxdb/v2/reference/glossary/#duration) without data after which the deadman triggers.",
+					},
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the check.",
+			},
+			"every": schema.StringAttribute{
+				Required:    true,
+				Description: "The interval [duration literal](https://docs.influxdata.com/influxdb/v2/reference/glossary/#duration) at which the check runs.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The check ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the labels to attach to the check.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"last_run_error": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error message from the last check run, if any.",
+			},
+			"last_run_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the last check run.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the check.",
+			},
+			"offset": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The duration to delay execution of the check after the scheduled time has elapsed.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID. Specifies the organization that owns the check.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The user ID. Specifies the owner of the check.",
+			},
+			"query": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The query the check runs.",
+				Attributes: map[string]schema.Attribute{
+					"edit_mode": schema.StringAttribute{
+						Computed:    true,
+						Optional:    true,
+						Default:     stringdefault.StaticString("advanced"),
+						Description: "The query edit mode used by the InfluxDB UI (`builder` or `advanced`). Default: `advanced`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"builder", "advanced"}...),
+						},
+					},
+					"text": schema.StringAttribute{
+						Required:    true,
+						Description: "The text of the Flux query.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The status of the check (`active` or `inactive`).",
+				Default:     stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"active", "inactive"}...),
+				},
+			},
+			"status_message_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "The template used to generate and write a status message, e.g. `Check: ${ r._check_name } is: ${ r._level }`.",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The tags to write to each status.",
+			},
+			"task_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the task InfluxDB created to run the check.",
+			},
+			"thresholds": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The thresholds to evaluate. Required when `type` is `threshold`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"all_values": schema.BoolAttribute{
+							Computed:    true,
+							Optional:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Only record the status if all values meet the threshold. Default: `false`.",
+						},
+						"level": schema.StringAttribute{
+							Required:    true,
+							Description: "The status level to record when the threshold matches. Valid values are `CRIT`, `WARN`, `INFO`, `OK` or `UNKNOWN`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(checkStatusLevels...),
+							},
+						},
+						"max": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The upper bound of a `range` threshold.",
+						},
+						"min": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The lower bound of a `range` threshold.",
+						},
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The threshold type. Valid values are `greater`, `lesser` or `range`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"greater", "lesser", "range"}...),
+							},
+						},
+						"value": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The value to compare against for `greater` and `lesser` thresholds.",
+						},
+						"within": schema.BoolAttribute{
+							Computed:    true,
+							Optional:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Match values within the range instead of outside of it. Only used by `range` thresholds. Default: `false`.",
+						},
+					},
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The check type. Valid values are `threshold` or `deadman`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"threshold", "deadman"}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the check was last updated.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates that the variant specific attributes match the check type.
+func (r *CheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var checkType types.String
+	var thresholds types.List
+	var deadman types.Object
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &checkType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("thresholds"), &thresholds)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deadman"), &deadman)...)
+	if resp.Diagnostics.HasError() || checkType.IsNull() || checkType.IsUnknown() {
+		return
+	}
+
+	switch checkType.ValueString() {
+	case "threshold":
+		if thresholds.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("thresholds"),
+				"Missing Attribute Configuration",
+				"At least one threshold must be configured when type is `threshold`.",
+			)
+		}
+		if !deadman.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deadman"),
+				"Invalid Attribute Combination",
+				"The deadman attribute can only be used when type is `deadman`.",
+			)
+		}
+	case "deadman":
+		if deadman.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deadman"),
+				"Missing Attribute Configuration",
+				"The deadman attribute must be configured when type is `deadman`.",
+			)
+		}
+		if !thresholds.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("thresholds"),
+				"Invalid Attribute Combination",
+				"The thresholds attribute can only be used when type is `threshold`.",
+			)
+		}
+	}
+
+	if thresholds.IsNull() || thresholds.IsUnknown() {
+		return
+	}
+
+	var thresholdModels []CheckThresholdModel
+	resp.Diagnostics.Append(thresholds.ElementsAs(ctx, &thresholdModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, threshold := range thresholdModels {
+		thresholdPath := path.Root("thresholds").AtListIndex(i)
+		switch threshold.Type.ValueString() {
+		case "greater", "lesser":
+			if threshold.Value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					thresholdPath.AtName("value"),
+					"Missing Attribute Configuration",
+					fmt.Sprintf("The value attribute must be configured for a `%s` threshold.", threshold.Type.ValueString()),
+				)
+			}
+			if !threshold.Min.IsNull() || !threshold.Max.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					thresholdPath,
+					"Invalid Attribute Combination",
+					"The min and max attributes can only be used by a `range` threshold.",
+				)
+			}
+		case "range":
+			if threshold.Min.IsNull() || threshold.Max.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					thresholdPath,
+					"Missing Attribute Configuration",
+					"Both min and max must be configured for a `range` threshold.",
+				)
+			}
+			if !threshold.Value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					thresholdPath.AtName("value"),
+					"Invalid Attribute Combination",
+					"The value attribute can only be used by `greater` and `lesser` thresholds.",
+				)
+			}
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CheckModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createCheck, diags := convertModelToDomainCheck(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponse, err := r.client.APIClient().CreateCheck(ctx, &domain.CreateCheckAllParams{
+		Body: createCheck,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating check",
+			"Could not create check",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeChecks),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state, diags := convertDomainCheckToModel(ctx, apiResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attach the configured labels
+	err = r.updateLabels(ctx, state.Id.ValueString(), types.SetNull(types.StringType), plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error adding labels to check",
+			"Could not add labels to check",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeChecks),
+		))
+
+		return
+	}
+	state.LabelIDs = plan.LabelIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state CheckModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed check value from InfluxDB
+	check, err := r.client.APIClient().GetChecksID(ctx, &domain.GetChecksIDAllParams{
+		CheckID: state.Id.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading check",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeChecks),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	newState, diags := convertDomainCheckToModel(ctx, check)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.LabelIDs = refreshedLabelIDs(state.LabelIDs, newState.LabelIDs)
+	newState.Timeouts = state.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CheckModel
+	var state CheckModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateCheck, diags := convertModelToDomainCheck(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing check
+	apiResponse, err := r.client.APIClient().PutChecksID(ctx, &domain.PutChecksIDAllParams{
+		CheckID: state.Id.ValueString(),
+		Body:    updateCheck,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating check",
+			"Could not update check",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeChecks),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newState, diags := convertDomainCheckToModel(ctx, apiResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.Timeouts = plan.Timeouts
+	newState.LabelIDs = state.LabelIDs
+
+	// Save updated data into Terraform state, so it matches InfluxDB if reconciling the labels fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the attached labels
+	err = r.updateLabels(ctx, state.Id.ValueString(), state.LabelIDs, plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating check labels",
+			"Could not update check labels",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeChecks),
+		))
+
+		return
+	}
+	newState.LabelIDs = plan.LabelIDs
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *CheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CheckModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing check
+	err := r.client.APIClient().DeleteChecksID(ctx, &domain.DeleteChecksIDAllParams{
+		CheckID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting check",
+			"Could not delete check",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeChecks),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_check")...)
+
+	r.client = client
+}
+
+func (r *CheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateLabels attaches and detaches labels so the check carries exactly the desired label IDs
+func (r *CheckResource) updateLabels(ctx context.Context, checkID string, current types.Set, desired types.Set) error {
+	var currentIDs, desiredIDs []string
+	if !current.IsNull() && !current.IsUnknown() {
+		if diags := current.ElementsAs(ctx, &currentIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read current label IDs")
+		}
+	}
+	if !desired.IsNull() && !desired.IsUnknown() {
+		if diags := desired.ElementsAs(ctx, &desiredIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read desired label IDs")
+		}
+	}
+
+	toAdd, toRemove := diffIDs(currentIDs, desiredIDs)
+	for _, labelID := range toAdd {
+		_, err := r.client.APIClient().PostChecksIDLabels(ctx, &domain.PostChecksIDLabelsAllParams{
+			CheckID: checkID,
+			Body:    domain.PostChecksIDLabelsJSONRequestBody{LabelID: &labelID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add label %s: %w", labelID, err)
+		}
+	}
+
+	for _, labelID := range toRemove {
+		err := r.client.APIClient().DeleteChecksIDLabelsID(ctx, &domain.DeleteChecksIDLabelsIDAllParams{
+			CheckID: checkID,
+			LabelID: labelID,
+		})
+		if err != nil && !apierror.IsNotFound(err) {
+			return fmt.Errorf("failed to remove label %s: %w", labelID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCheckResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccCheckResourceThresholdConfig("test-check", 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_check.test", "id"),
+					resource.TestCheckResourceAttrSet("influxdb_check.test", "task_id"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "name", "test-check"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "type", "threshold"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "status", "active"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttr("influxdb_check.test", "thresholds.#", "2"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "thresholds.0.value", "80"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "label_ids.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccCheckResourceThresholdConfig("test-check-updated", 90.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_check.test", "name", "test-check-updated"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "thresholds.0.value", "90.5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCheckResourceDeadman(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccCheckResourceDeadmanConfig("inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_check.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "type", "deadman"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "status", "inactive"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "deadman.level", "CRIT"),
+					resource.TestCheckResourceAttr("influxdb_check.test", "deadman.time_since", "90s"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceThresholdConfig(name string, critValue float64) string {
+	return fmt.Sprintf(`
+resource "influxdb_label" "test" {
+  name   = "test-check-label"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_check" "test" {
+  name   = %[1]q
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  type   = "threshold"
+  every  = "1m"
+  query = {
+    text = <<-EOT
+      from(bucket: "test-bucket")
+        |> range(start: -1m)
+        |> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage_user")
+        |> aggregateWindow(every: 1m, fn: mean, createEmpty: false)
+    EOT
+  }
+  status_message_template = "Check: $${ r._check_name } is: $${ r._level }"
+  tags = {
+    team = "platform"
+  }
+  thresholds = [
+    {
+      type  = "greater"
+      level = "CRIT"
+      value = %[2]g
+    },
+    {
+      type  = "range"
+      level = "WARN"
+      min   = 60
+      max   = 80
+    },
+  ]
+  label_ids = [influxdb_label.test.id]
+}
+`, name, critValue)
+}
+
+func testAccCheckResourceDeadmanConfig(status string) string {
+	return fmt.Sprintf(`
+resource "influxdb_check" "test" {
+  name   = "test-deadman-check"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  type   = "deadman"
+  every  = "1m"
+  status = %[1]q
+  query = {
+    text = <<-EOT
+      from(bucket: "test-bucket")
+        |> range(start: -5m)
+        |> filter(fn: (r) => r._measurement == "cpu" and r._field == "usage_user")
+    EOT
+  }
+  status_message_template = "Check: $${ r._check_name } is: $${ r._level }"
+  deadman = {
+    level      = "CRIT"
+    time_since = "90s"
+  }
+}
+`, status)
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// DashboardModel maps InfluxDB dashboard schema data.
+type DashboardModel struct {
+	Cells       []DashboardCellModel `tfsdk:"cells"`
+	Description types.String         `tfsdk:"description"`
+	Id          types.String         `tfsdk:"id"`
+	LabelIDs    types.Set            `tfsdk:"label_ids"`
+	Name        types.String         `tfsdk:"name"`
+	OrgID       types.String         `tfsdk:"org_id"`
+	Timeouts    timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// DashboardCellModel maps InfluxDB dashboard cell schema data.
+type DashboardCellModel struct {
+	H          types.Int64                   `tfsdk:"h"`
+	Id         types.String                  `tfsdk:"id"`
+	Markdown   *DashboardMarkdownViewModel   `tfsdk:"markdown"`
+	Name       types.String                  `tfsdk:"name"`
+	SingleStat *DashboardSingleStatViewModel `tfsdk:"single_stat"`
+	Table      *DashboardTableViewModel      `tfsdk:"table"`
+	W          types.Int64                   `tfsdk:"w"`
+	X          types.Int64                   `tfsdk:"x"`
+	XY         *DashboardXYViewModel         `tfsdk:"xy"`
+	Y          types.Int64                   `tfsdk:"y"`
+}
+
+// DashboardXYViewModel maps InfluxDB graph view schema data.
+type DashboardXYViewModel struct {
+	Geom       types.String `tfsdk:"geom"`
+	Note       types.String `tfsdk:"note"`
+	Position   types.String `tfsdk:"position"`
+	Queries    types.List   `tfsdk:"queries"`
+	ShadeBelow types.Bool   `tfsdk:"shade_below"`
+}
+
+// DashboardSingleStatViewModel maps InfluxDB single stat view schema data.
+type DashboardSingleStatViewModel struct {
+	DecimalPlaces types.Int64  `tfsdk:"decimal_places"`
+	Note          types.String `tfsdk:"note"`
+	Prefix        types.String `tfsdk:"prefix"`
+	Queries       types.List   `tfsdk:"queries"`
+	Suffix        types.String `tfsdk:"suffix"`
+}
+
+// DashboardTableViewModel maps InfluxDB table view schema data.
+type DashboardTableViewModel struct {
+	DecimalPlaces types.Int64  `tfsdk:"decimal_places"`
+	Note          types.String `tfsdk:"note"`
+	Queries       types.List   `tfsdk:"queries"`
+}
+
+// DashboardMarkdownViewModel maps InfluxDB markdown view schema data.
+type DashboardMarkdownViewModel struct {
+	Note types.String `tfsdk:"note"`
+}
+
+// dashboardJSON is the wire format of a dashboard fetched with its cell view properties.
+// domain.ViewProperties is an untyped interface, so the view fields managed by this
+// provider are flattened into dashboardViewPropertiesJSON.
+type dashboardJSON struct {
+	domain.CreateDashboardRequest
+
+	Cells  []dashboardCellJSON `json:"cells,omitempty"`
+	Id     *string             `json:"id,omitempty"`
+	Labels *domain.Labels      `json:"labels,omitempty"`
+}
+
+// dashboardCellJSON is the wire format of a dashboard cell with its view.
+type dashboardCellJSON struct {
+	domain.Cell
+
+	Name       *string                      `json:"name,omitempty"`
+	Properties *dashboardViewPropertiesJSON `json:"properties,omitempty"`
+}
+
+// dashboardViewPropertiesJSON is the wire format of the view properties managed by this provider.
+type dashboardViewPropertiesJSON struct {
+	DecimalPlaces *domain.DecimalPlaces   `json:"decimalPlaces,omitempty"`
+	Geom          string                  `json:"geom,omitempty"`
+	Note          string                  `json:"note,omitempty"`
+	Position      string                  `json:"position,omitempty"`
+	Prefix        string                  `json:"prefix,omitempty"`
+	Queries       []domain.DashboardQuery `json:"queries,omitempty"`
+	ShadeBelow    *bool                   `json:"shadeBelow,omitempty"`
+	Suffix        string                  `json:"suffix,omitempty"`
+	Type          string                  `json:"type"`
+}
+
+// convertDashboardCellToView converts a DashboardCellModel to the domain.View of the cell.
+func convertDashboardCellToView(ctx context.Context, cell DashboardCellModel) (domain.View, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	view := domain.View{
+		Name: cell.Name.ValueString(),
+	}
+
+	switch {
+	case cell.XY != nil:
+		queries, queryDiags := convertQueryListToDashboardQueries(ctx, cell.XY.Queries)
+		diags.Append(queryDiags...)
+		view.Properties = domain.XYViewProperties{
+			Colors:     []domain.DashboardColor{},
+			Geom:       domain.XYGeom(cell.XY.Geom.ValueString()),
+			Note:       cell.XY.Note.ValueString(),
+			Position:   domain.XYViewPropertiesPosition(cell.XY.Position.ValueString()),
+			Queries:    queries,
+			ShadeBelow: cell.XY.ShadeBelow.ValueBoolPointer(),
+			Shape:      domain.XYViewPropertiesShapeChronografV2,
+			Type:       domain.XYViewPropertiesTypeXy,
+		}
+	case cell.SingleStat != nil:
+		queries, queryDiags := convertQueryListToDashboardQueries(ctx, cell.SingleStat.Queries)
+		diags.Append(queryDiags...)
+		view.Properties = domain.SingleStatViewProperties{
+			Colors:        []domain.DashboardColor{},
+			DecimalPlaces: convertInt64ToDecimalPlaces(cell.SingleStat.DecimalPlaces),
+			Note:          cell.SingleStat.Note.ValueString(),
+			Prefix:        cell.SingleStat.Prefix.ValueString(),
+			Queries:       queries,
+			Shape:         domain.SingleStatViewPropertiesShapeChronografV2,
+			Suffix:        cell.SingleStat.Suffix.ValueString(),
+			Type:          domain.SingleStatViewPropertiesTypeSingleStat,
+		}
+	case cell.Table != nil:
+		queries, queryDiags := convertQueryListToDashboardQueries(ctx, cell.Table.Queries)
+		diags.Append(queryDiags...)
+		view.Properties = domain.TableViewProperties{
+			Colors:        []domain.DashboardColor{},
+			DecimalPlaces: convertInt64ToDecimalPlaces(cell.Table.DecimalPlaces),
+			FieldOptions:  []domain.RenamableField{},
+			Note:          cell.Table.Note.ValueString(),
+			Queries:       queries,
+			Shape:         domain.TableViewPropertiesShapeChronografV2,
+			Type:          domain.TableViewPropertiesTypeTable,
+		}
+	case cell.Markdown != nil:
+		view.Properties = domain.MarkdownViewProperties{
+			Note:  cell.Markdown.Note.ValueString(),
+			Shape: domain.MarkdownViewPropertiesShapeChronografV2,
+			Type:  domain.MarkdownViewPropertiesTypeMarkdown,
+		}
+	}
+
+	return view, diags
+}
+
+// convertDashboardToModel converts a dashboard to DashboardModel. Cells are kept in the order
+// of prior, matched by cell ID; cells not in prior are appended ordered by position.
+func convertDashboardToModel(ctx context.Context, dashboard dashboardJSON, prior []DashboardCellModel) (DashboardModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	order := make(map[string]int, len(prior))
+	for i, cell := range prior {
+		order[cell.Id.ValueString()] = i
+	}
+
+	cellsJSON := dashboard.Cells
+	sort.SliceStable(cellsJSON, func(i, j int) bool {
+		iOrder, iKnown := order[stringPointerValue(cellsJSON[i].Id)]
+		jOrder, jKnown := order[stringPointerValue(cellsJSON[j].Id)]
+		switch {
+		case iKnown && jKnown:
+			return iOrder < jOrder
+		case iKnown != jKnown:
+			return iKnown
+		}
+
+		iY, jY := int32PointerValue(cellsJSON[i].Y), int32PointerValue(cellsJSON[j].Y)
+		if iY != jY {
+			return iY < jY
+		}
+
+		return int32PointerValue(cellsJSON[i].X) < int32PointerValue(cellsJSON[j].X)
+	})
+
+	var cells []DashboardCellModel
+	for _, cellJSON := range cellsJSON {
+		cell, cellDiags := convertDashboardCellToModel(ctx, cellJSON)
+		diags.Append(cellDiags...)
+		cells = append(cells, cell)
+	}
+
+	labelIDs, labelDiags := convertLabelsToIDSet(ctx, dashboard.Labels)
+	diags.Append(labelDiags...)
+
+	return DashboardModel{
+		Cells:       cells,
+		Description: types.StringPointerValue(dashboard.Description),
+		Id:          types.StringPointerValue(dashboard.Id),
+		LabelIDs:    labelIDs,
+		Name:        types.StringValue(dashboard.Name),
+		OrgID:       types.StringValue(dashboard.OrgID),
+	}, diags
+}
+
+// convertDashboardCellToModel converts a dashboard cell to DashboardCellModel.
+func convertDashboardCellToModel(ctx context.Context, cellJSON dashboardCellJSON) (DashboardCellModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cell := DashboardCellModel{
+		H:    types.Int64Value(int64(int32PointerValue(cellJSON.H))),
+		Id:   types.StringPointerValue(cellJSON.Id),
+		Name: types.StringValue(stringPointerValue(cellJSON.Name)),
+		W:    types.Int64Value(int64(int32PointerValue(cellJSON.W))),
+		X:    types.Int64Value(int64(int32PointerValue(cellJSON.X))),
+		Y:    types.Int64Value(int64(int32PointerValue(cellJSON.Y))),
+	}
+
+	properties := cellJSON.Properties
+	if properties == nil {
+		return cell, diags
+	}
+
+	queries, queryDiags := convertDashboardQueriesToList(ctx, properties.Queries)
+	diags.Append(queryDiags...)
+
+	switch properties.Type {
+	case string(domain.XYViewPropertiesTypeXy):
+		cell.XY = &DashboardXYViewModel{
+			Geom:       types.StringValue(properties.Geom),
+			Note:       convertEmptyStringToNull(properties.Note),
+			Position:   types.StringValue(properties.Position),
+			Queries:    queries,
+			ShadeBelow: types.BoolValue(properties.ShadeBelow != nil && *properties.ShadeBelow),
+		}
+	case string(domain.SingleStatViewPropertiesTypeSingleStat):
+		cell.SingleStat = &DashboardSingleStatViewModel{
+			DecimalPlaces: convertDecimalPlacesToInt64(properties.DecimalPlaces),
+			Note:          convertEmptyStringToNull(properties.Note),
+			Prefix:        convertEmptyStringToNull(properties.Prefix),
+			Queries:       queries,
+			Suffix:        convertEmptyStringToNull(properties.Suffix),
+		}
+	case string(domain.TableViewPropertiesTypeTable):
+		cell.Table = &DashboardTableViewModel{
+			DecimalPlaces: convertDecimalPlacesToInt64(properties.DecimalPlaces),
+			Note:          convertEmptyStringToNull(properties.Note),
+			Queries:       queries,
+		}
+	case string(domain.MarkdownViewPropertiesTypeMarkdown):
+		cell.Markdown = &DashboardMarkdownViewModel{
+			Note: types.StringValue(properties.Note),
+		}
+	}
+
+	return cell, diags
+}
+
+// convertQueryListToDashboardQueries converts a list of Flux query strings to dashboard queries.
+func convertQueryListToDashboardQueries(ctx context.Context, list types.List) ([]domain.DashboardQuery, diag.Diagnostics) {
+	var texts []string
+	diags := list.ElementsAs(ctx, &texts, false)
+
+	editMode := domain.QueryEditModeAdvanced
+	queries := make([]domain.DashboardQuery, 0, len(texts))
+	for _, text := range texts {
+		queries = append(queries, domain.DashboardQuery{
+			EditMode: &editMode,
+			Text:     &text,
+		})
+	}
+
+	return queries, diags
+}
+
+// convertDashboardQueriesToList converts dashboard queries to a list of Flux query strings.
+func convertDashboardQueriesToList(ctx context.Context, queries []domain.DashboardQuery) (types.List, diag.Diagnostics) {
+	texts := make([]string, 0, len(queries))
+	for _, query := range queries {
+		texts = append(texts, stringPointerValue(query.Text))
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, texts)
+}
+
+// convertInt64ToDecimalPlaces converts an optional number of decimal places to domain.DecimalPlaces.
+func convertInt64ToDecimalPlaces(value types.Int64) domain.DecimalPlaces {
+	enforced := !value.IsNull() && !value.IsUnknown()
+	decimalPlaces := domain.DecimalPlaces{
+		IsEnforced: &enforced,
+	}
+	if enforced {
+		digits := int32(value.ValueInt64())
+		decimalPlaces.Digits = &digits
+	}
+
+	return decimalPlaces
+}
+
+// convertDecimalPlacesToInt64 converts domain.DecimalPlaces to the number of enforced decimal places.
+func convertDecimalPlacesToInt64(decimalPlaces *domain.DecimalPlaces) types.Int64 {
+	if decimalPlaces == nil || decimalPlaces.IsEnforced == nil || !*decimalPlaces.IsEnforced || decimalPlaces.Digits == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*decimalPlaces.Digits))
+}
+
+// convertEmptyStringToNull converts an empty string to a null types.String.
+func convertEmptyStringToNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// stringPointerValue returns the value of a string pointer, or an empty string if it is nil.
+func stringPointerValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// int32PointerValue returns the value of an int32 pointer, or zero if it is nil.
+func int32PointerValue(value *int32) int32 {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
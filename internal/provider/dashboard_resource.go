@@ -0,0 +1,731 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &DashboardResource{}
+	_ resource.ResourceWithImportState    = &DashboardResource{}
+	_ resource.ResourceWithValidateConfig = &DashboardResource{}
+)
+
+// NewDashboardResource is a helper function to simplify the provider implementation.
+func NewDashboardResource() resource.Resource {
+	return &DashboardResource{}
+}
+
+// DashboardResource defines the resource implementation.
+type DashboardResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *DashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+// Schema defines the schema for the resource.
+func (r *DashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	queriesAttribute := schema.ListAttribute{
+		Required:    true,
+		ElementType: types.StringType,
+		Description: "The Flux queries rendered by the view.",
+	}
+	noteAttribute := schema.StringAttribute{
+		Optional:    true,
+		Description: "A note shown with the view.",
+	}
+	decimalPlacesAttribute := schema.Int64Attribute{
+		Optional:    true,
+		Description: "The number of decimal places to display. Not enforced when unset.",
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a dashboard and its cells. Cells are matched to existing cells by their position in the list, so changes update cells and views in place.",
+
+		Attributes: map[string]schema.Attribute{
+			"cells": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The cells of the dashboard. Each cell sets exactly one of `xy`, `single_stat`, `table` or `markdown`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"h": schema.Int64Attribute{
+							Required:    true,
+							Description: "The height of the cell in grid units.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The cell ID.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"markdown": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "A markdown note view.",
+							Attributes: map[string]schema.Attribute{
+								"note": schema.StringAttribute{
+									Required:    true,
+									Description: "The markdown text.",
+								},
+							},
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the cell view.",
+						},
+						"single_stat": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "A single stat view showing the latest value of a query.",
+							Attributes: map[string]schema.Attribute{
+								"decimal_places": decimalPlacesAttribute,
+								"note":           noteAttribute,
+								"prefix": schema.StringAttribute{
+									Optional:    true,
+									Description: "The text shown before the value.",
+								},
+								"queries": queriesAttribute,
+								"suffix": schema.StringAttribute{
+									Optional:    true,
+									Description: "The text shown after the value.",
+								},
+							},
+						},
+						"table": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "A table view.",
+							Attributes: map[string]schema.Attribute{
+								"decimal_places": decimalPlacesAttribute,
+								"note":           noteAttribute,
+								"queries":        queriesAttribute,
+							},
+						},
+						"w": schema.Int64Attribute{
+							Required:    true,
+							Description: "The width of the cell in grid units.",
+						},
+						"x": schema.Int64Attribute{
+							Computed:    true,
+							Optional:    true,
+							Description: "The horizontal position of the cell in grid units.",
+							Default:     int64default.StaticInt64(0),
+						},
+						"xy": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "A graph view.",
+							Attributes: map[string]schema.Attribute{
+								"geom": schema.StringAttribute{
+									Computed:    true,
+									Optional:    true,
+									Description: "The graph geometry (`line`, `step`, `stepBefore`, `stepAfter`, `stacked`, `bar` or `monotoneX`). Defaults to `line`.",
+									Default:     stringdefault.StaticString(string(domain.XYGeomLine)),
+									Validators: []validator.String{
+										stringvalidator.OneOf([]string{"line", "step", "stepBefore", "stepAfter", "stacked", "bar", "monotoneX"}...),
+									},
+								},
+								"note": noteAttribute,
+								"position": schema.StringAttribute{
+									Computed:    true,
+									Optional:    true,
+									Description: "How multiple series are drawn (`overlaid` or `stacked`). Defaults to `overlaid`.",
+									Default:     stringdefault.StaticString(string(domain.XYViewPropertiesPositionOverlaid)),
+									Validators: []validator.String{
+										stringvalidator.OneOf([]string{"overlaid", "stacked"}...),
+									},
+								},
+								"queries": queriesAttribute,
+								"shade_below": schema.BoolAttribute{
+									Computed:    true,
+									Optional:    true,
+									Description: "Whether to shade the area below the graph lines. Defaults to `false`.",
+									Default:     booldefault.StaticBool(false),
+								},
+							},
+						},
+						"y": schema.Int64Attribute{
+							Computed:    true,
+							Optional:    true,
+							Description: "The vertical position of the cell in grid units.",
+							Default:     int64default.StaticInt64(0),
+						},
+					},
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the dashboard.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The dashboard ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the labels attached to the dashboard.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the dashboard.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates that each cell has exactly one view.
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The cells are read as a list of objects, as the cells or their views may be unknown, e.g. when set from a module output
+	var cells types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cells"), &cells)...)
+	if resp.Diagnostics.HasError() || cells.IsUnknown() || cells.IsNull() {
+		return
+	}
+
+	for i, element := range cells.Elements() {
+		cell, ok := element.(types.Object)
+		if !ok || cell.IsUnknown() || cell.IsNull() {
+			continue
+		}
+
+		views := 0
+		unknown := false
+		for _, name := range []string{"xy", "single_stat", "table", "markdown"} {
+			view, ok := cell.Attributes()[name]
+			if !ok || view.IsNull() {
+				continue
+			}
+			if view.IsUnknown() {
+				unknown = true
+				break
+			}
+			views++
+		}
+		if !unknown && views != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cells").AtListIndex(i),
+				"Invalid dashboard cell",
+				"Each cell must set exactly one of xy, single_stat, table or markdown.",
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DashboardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createDashboard := domain.CreateDashboardRequest{
+		Description: plan.Description.ValueStringPointer(),
+		Name:        plan.Name.ValueString(),
+		OrgID:       plan.OrgID.ValueString(),
+	}
+
+	var apiResponse dashboardJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodPost, "dashboards", createDashboard, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating dashboard",
+			"Could not create dashboard",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeDashboards),
+		))
+
+		return
+	}
+	dashboardID := *apiResponse.Id
+
+	// Save the created dashboard into Terraform state, so it is tracked if adding the cells or labels fails
+	state, diags := convertDashboardToModel(ctx, apiResponse, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	state.LabelIDs = types.SetNull(types.StringType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the configured cells
+	resp.Diagnostics.Append(r.updateCells(ctx, dashboardID, nil, plan.Cells)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attach the configured labels
+	err = r.updateLabels(ctx, dashboardID, types.SetNull(types.StringType), plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error adding labels to dashboard",
+			"Could not add labels to dashboard",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeDashboards),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state, diags = r.readDashboard(ctx, dashboardID, plan.Cells)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	state.LabelIDs = plan.LabelIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state DashboardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed dashboard value from InfluxDB
+	var dashboard dashboardJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodGet, "dashboards/"+state.Id.ValueString()+"?include=properties", nil, &dashboard)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading dashboard",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeDashboards),
+		))
+
+		return
+	}
+
+	newState, diags := convertDashboardToModel(ctx, dashboard, state.Cells)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.LabelIDs = refreshedLabelIDs(state.LabelIDs, newState.LabelIDs)
+	newState.Timeouts = state.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DashboardModel
+	var state DashboardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing dashboard
+	apiResponse, err := r.client.APIClient().PatchDashboardsID(ctx, &domain.PatchDashboardsIDAllParams{
+		DashboardID: state.Id.ValueString(),
+		Body: domain.PatchDashboardsIDJSONRequestBody{
+			Description: plan.Description.ValueStringPointer(),
+			Name:        plan.Name.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating dashboard",
+			"Could not update dashboard",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeDashboards),
+		))
+
+		return
+	}
+
+	// Save the updated dashboard into Terraform state, so it matches InfluxDB if reconciling the cells or labels fails
+	patchedState := state
+	patchedState.Description = types.StringPointerValue(apiResponse.Description)
+	patchedState.Name = types.StringValue(apiResponse.Name)
+	patchedState.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &patchedState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the cells
+	resp.Diagnostics.Append(r.updateCells(ctx, state.Id.ValueString(), state.Cells, plan.Cells)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reconcile the attached labels
+	err = r.updateLabels(ctx, state.Id.ValueString(), state.LabelIDs, plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating dashboard labels",
+			"Could not update dashboard labels",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeDashboards),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newState, diags := r.readDashboard(ctx, state.Id.ValueString(), plan.Cells)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.Timeouts = plan.Timeouts
+	newState.LabelIDs = plan.LabelIDs
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DashboardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing dashboard
+	err := r.client.APIClient().DeleteDashboardsID(ctx, &domain.DeleteDashboardsIDAllParams{
+		DashboardID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting dashboard",
+			"Could not delete dashboard",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeDashboards),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_dashboard")...)
+
+	r.client = client
+}
+
+func (r *DashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readDashboard fetches a dashboard with its cell views and converts it to DashboardModel
+func (r *DashboardResource) readDashboard(ctx context.Context, dashboardID string, prior []DashboardCellModel) (DashboardModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var dashboard dashboardJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodGet, "dashboards/"+dashboardID+"?include=properties", nil, &dashboard)
+	if err != nil {
+		diags.Append(apierror.Diagnostic(
+			"Error reading dashboard",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeDashboards),
+		))
+
+		return DashboardModel{}, diags
+	}
+
+	return convertDashboardToModel(ctx, dashboard, prior)
+}
+
+// updateCells updates the dashboard cells in place so they match desired. Cells are matched by
+// their position in the list: existing cells are moved and have their view replaced when it
+// changed, extra desired cells are created and cells no longer desired are deleted.
+func (r *DashboardResource) updateCells(ctx context.Context, dashboardID string, current []DashboardCellModel, desired []DashboardCellModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	kept := make(map[string]bool, len(desired))
+	for i, cell := range desired {
+		cellID := cell.Id.ValueString()
+		var existing *DashboardCellModel
+		if i < len(current) && !cell.Id.IsUnknown() && cellID == current[i].Id.ValueString() {
+			existing = &current[i]
+		}
+
+		position := domain.CellUpdate{
+			H: int64ToInt32Pointer(cell.H),
+			W: int64ToInt32Pointer(cell.W),
+			X: int64ToInt32Pointer(cell.X),
+			Y: int64ToInt32Pointer(cell.Y),
+		}
+
+		if existing == nil {
+			createdCell, err := r.client.APIClient().PostDashboardsIDCells(ctx, &domain.PostDashboardsIDCellsAllParams{
+				DashboardID: dashboardID,
+				Body: domain.PostDashboardsIDCellsJSONRequestBody{
+					H:    position.H,
+					Name: cell.Name.ValueStringPointer(),
+					W:    position.W,
+					X:    position.X,
+					Y:    position.Y,
+				},
+			})
+			if err != nil {
+				diags.Append(apierror.Diagnostic(
+					"Error creating dashboard cell",
+					"Could not create dashboard cell",
+					err,
+					apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeDashboards),
+				))
+
+				return diags
+			}
+			cellID = *createdCell.Id
+		} else if !existing.H.Equal(cell.H) || !existing.W.Equal(cell.W) || !existing.X.Equal(cell.X) || !existing.Y.Equal(cell.Y) {
+			_, err := r.client.APIClient().PatchDashboardsIDCellsID(ctx, &domain.PatchDashboardsIDCellsIDAllParams{
+				DashboardID: dashboardID,
+				CellID:      cellID,
+				Body:        domain.PatchDashboardsIDCellsIDJSONRequestBody(position),
+			})
+			if err != nil {
+				diags.Append(apierror.Diagnostic(
+					"Error updating dashboard cell",
+					"Could not update dashboard cell",
+					err,
+					apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeDashboards),
+				))
+
+				return diags
+			}
+		}
+		kept[cellID] = true
+
+		view, viewDiags := convertDashboardCellToView(ctx, cell)
+		diags.Append(viewDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if existing != nil {
+			existingView, viewDiags := convertDashboardCellToView(ctx, *existing)
+			diags.Append(viewDiags...)
+			if reflect.DeepEqual(view, existingView) {
+				continue
+			}
+		}
+
+		_, err := r.client.APIClient().PatchDashboardsIDCellsIDView(ctx, &domain.PatchDashboardsIDCellsIDViewAllParams{
+			DashboardID: dashboardID,
+			CellID:      cellID,
+			Body:        domain.PatchDashboardsIDCellsIDViewJSONRequestBody(view),
+		})
+		if err != nil {
+			diags.Append(apierror.Diagnostic(
+				"Error updating dashboard cell view",
+				"Could not update dashboard cell view",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeDashboards),
+			))
+
+			return diags
+		}
+	}
+
+	for _, cell := range current {
+		if kept[cell.Id.ValueString()] {
+			continue
+		}
+
+		err := r.client.APIClient().DeleteDashboardsIDCellsID(ctx, &domain.DeleteDashboardsIDCellsIDAllParams{
+			DashboardID: dashboardID,
+			CellID:      cell.Id.ValueString(),
+		})
+		if err != nil && !apierror.IsNotFound(err) {
+			diags.Append(apierror.Diagnostic(
+				"Error deleting dashboard cell",
+				"Could not delete dashboard cell",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeDashboards),
+			))
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// updateLabels attaches and detaches labels so the dashboard carries exactly the desired label IDs
+func (r *DashboardResource) updateLabels(ctx context.Context, dashboardID string, current types.Set, desired types.Set) error {
+	var currentIDs, desiredIDs []string
+	if !current.IsNull() && !current.IsUnknown() {
+		if diags := current.ElementsAs(ctx, &currentIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read current label IDs")
+		}
+	}
+	if !desired.IsNull() && !desired.IsUnknown() {
+		if diags := desired.ElementsAs(ctx, &desiredIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read desired label IDs")
+		}
+	}
+
+	toAdd, toRemove := diffIDs(currentIDs, desiredIDs)
+	for _, labelID := range toAdd {
+		_, err := r.client.APIClient().PostDashboardsIDLabels(ctx, &domain.PostDashboardsIDLabelsAllParams{
+			DashboardID: dashboardID,
+			Body:        domain.PostDashboardsIDLabelsJSONRequestBody{LabelID: &labelID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add label %s: %w", labelID, err)
+		}
+	}
+
+	for _, labelID := range toRemove {
+		err := r.client.APIClient().DeleteDashboardsIDLabelsID(ctx, &domain.DeleteDashboardsIDLabelsIDAllParams{
+			DashboardID: dashboardID,
+			LabelID:     labelID,
+		})
+		if err != nil && !apierror.IsNotFound(err) {
+			return fmt.Errorf("failed to remove label %s: %w", labelID, err)
+		}
+	}
+
+	return nil
+}
+
+// int64ToInt32Pointer converts a types.Int64 to an *int32.
+func int64ToInt32Pointer(value types.Int64) *int32 {
+	v := int32(value.ValueInt64())
+	return &v
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDashboardResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccDashboardResourceConfig("test-dashboard", 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "name", "test-dashboard"),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "label_ids.#", "1"),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "cells.#", "2"),
+					resource.TestCheckResourceAttrSet("influxdb_dashboard.test", "cells.0.id"),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "cells.0.xy.geom", "line"),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "cells.0.w", "4"),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "cells.1.markdown.note", "# Notes"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_dashboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccDashboardResourceConfig("test-dashboard-updated", 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "name", "test-dashboard-updated"),
+					resource.TestCheckResourceAttr("influxdb_dashboard.test", "cells.0.w", "6"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDashboardResourceConfig(name string, width int) string {
+	return fmt.Sprintf(`
+resource "influxdb_label" "test" {
+  name   = "test-dashboard-label"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_dashboard" "test" {
+  name        = %[1]q
+  description = "Test dashboard"
+  org_id      = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  label_ids   = [influxdb_label.test.id]
+  cells = [
+    {
+      name = "CPU"
+      w    = %[2]d
+      h    = 3
+      xy = {
+        queries = ["from(bucket: \"test\") |> range(start: v.timeRangeStart)"]
+      }
+    },
+    {
+      name = "Notes"
+      x    = %[2]d
+      w    = 4
+      h    = 3
+      markdown = {
+        note = "# Notes"
+      }
+    },
+  ]
+}
+`, name, width)
+}
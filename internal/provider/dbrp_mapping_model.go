@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// DBRPMappingModel maps InfluxDB DBRP mapping schema data.
+type DBRPMappingModel struct {
+	BucketID        types.String `tfsdk:"bucket_id"`
+	Database        types.String `tfsdk:"database"`
+	Default         types.Bool   `tfsdk:"default"`
+	Id              types.String `tfsdk:"id"`
+	OrgID           types.String `tfsdk:"org_id"`
+	RetentionPolicy types.String `tfsdk:"retention_policy"`
+	Virtual         types.Bool   `tfsdk:"virtual"`
+}
+
+// DBRPMappingResourceModel maps the DBRP mapping resource schema data, adding the timeouts to DBRPMappingModel.
+type DBRPMappingResourceModel struct {
+	DBRPMappingModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// convertDBRPToModel converts a domain.DBRP to DBRPMappingModel.
+func convertDBRPToModel(dbrp *domain.DBRP) DBRPMappingModel {
+	return DBRPMappingModel{
+		BucketID:        types.StringValue(dbrp.BucketID),
+		Database:        types.StringValue(dbrp.Database),
+		Default:         types.BoolValue(dbrp.Default),
+		Id:              types.StringValue(dbrp.Id),
+		OrgID:           types.StringValue(dbrp.OrgID),
+		RetentionPolicy: types.StringValue(dbrp.RetentionPolicy),
+		Virtual:         types.BoolValue(dbrp.Virtual != nil && *dbrp.Virtual),
+	}
+}
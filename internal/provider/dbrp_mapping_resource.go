@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DBRPMappingResource{}
+	_ resource.ResourceWithImportState = &DBRPMappingResource{}
+)
+
+// NewDBRPMappingResource is a helper function to simplify the provider implementation.
+func NewDBRPMappingResource() resource.Resource {
+	return &DBRPMappingResource{}
+}
+
+// DBRPMappingResource defines the resource implementation.
+type DBRPMappingResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *DBRPMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbrp_mapping"
+}
+
+// Schema defines the schema for the resource.
+func (r *DBRPMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a database and retention policy (DBRP) mapping. DBRP mappings let InfluxDB v1 compatible clients query and write to a bucket using InfluxQL. Existing mappings are imported using `<org_id>/<dbrp_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"bucket_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the bucket used as the target for the translation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "The InfluxDB v1 database name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the mapping is the default retention policy for the database. Defaults to `false`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The DBRP mapping ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention_policy": schema.StringAttribute{
+				Required:    true,
+				Description: "The InfluxDB v1 retention policy name.",
+			},
+			"virtual": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates an autogenerated, virtual mapping based on the bucket name.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DBRPMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DBRPMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createDBRP := domain.DBRPCreate{
+		BucketID:        plan.BucketID.ValueString(),
+		Database:        plan.Database.ValueString(),
+		Default:         plan.Default.ValueBoolPointer(),
+		OrgID:           plan.OrgID.ValueStringPointer(),
+		RetentionPolicy: plan.RetentionPolicy.ValueString(),
+	}
+
+	apiResponse, err := r.client.APIClient().PostDBRP(ctx, &domain.PostDBRPAllParams{
+		Body: domain.PostDBRPJSONRequestBody(createDBRP),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating DBRP mapping",
+			"Could not create DBRP mapping",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeDbrp),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := DBRPMappingResourceModel{
+		DBRPMappingModel: convertDBRPToModel(apiResponse),
+		Timeouts:         plan.Timeouts,
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *DBRPMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state DBRPMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed DBRP mapping value from InfluxDB
+	dbrp, err := r.client.APIClient().GetDBRPsID(ctx, &domain.GetDBRPsIDAllParams{
+		GetDBRPsIDParams: domain.GetDBRPsIDParams{OrgID: state.OrgID.ValueStringPointer()},
+		DbrpID:           state.Id.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading DBRP mapping",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeDbrp),
+		))
+
+		return
+	}
+
+	// The DBRP mapping was removed outside of Terraform
+	if dbrp.Content == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.DBRPMappingModel = convertDBRPToModel(dbrp.Content)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DBRPMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DBRPMappingResourceModel
+	var state DBRPMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing DBRP mapping
+	apiResponse, err := r.client.APIClient().PatchDBRPID(ctx, &domain.PatchDBRPIDAllParams{
+		PatchDBRPIDParams: domain.PatchDBRPIDParams{OrgID: state.OrgID.ValueStringPointer()},
+		DbrpID:            state.Id.ValueString(),
+		Body: domain.PatchDBRPIDJSONRequestBody{
+			Default:         plan.Default.ValueBoolPointer(),
+			RetentionPolicy: plan.RetentionPolicy.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating DBRP mapping",
+			"Could not update DBRP mapping",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeDbrp),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	if apiResponse.Content != nil {
+		state.DBRPMappingModel = convertDBRPToModel(apiResponse.Content)
+	} else {
+		state.Default = plan.Default
+		state.RetentionPolicy = plan.RetentionPolicy
+	}
+
+	state.Timeouts = plan.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DBRPMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DBRPMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing DBRP mapping
+	err := r.client.APIClient().DeleteDBRPID(ctx, &domain.DeleteDBRPIDAllParams{
+		DeleteDBRPIDParams: domain.DeleteDBRPIDParams{OrgID: state.OrgID.ValueStringPointer()},
+		DbrpID:             state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting DBRP mapping",
+			"Could not delete DBRP mapping",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeDbrp),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *DBRPMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_dbrp_mapping")...)
+
+	r.client = client
+}
+
+// ImportState imports a DBRP mapping. The DBRP API requires the organization,
+// so the import ID has the form <org_id>/<dbrp_id>.
+func (r *DBRPMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: org_id/dbrp_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDBRPMappingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccDBRPMappingResourceConfig("autogen", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_dbrp_mapping.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_dbrp_mapping.test", "database", "test_dbrp"),
+					resource.TestCheckResourceAttr("influxdb_dbrp_mapping.test", "retention_policy", "autogen"),
+					resource.TestCheckResourceAttr("influxdb_dbrp_mapping.test", "default", "false"),
+					resource.TestCheckResourceAttr("influxdb_dbrp_mapping.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttrPair("influxdb_dbrp_mapping.test", "bucket_id", "influxdb_bucket.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_dbrp_mapping.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["influxdb_dbrp_mapping.test"]
+					return rs.Primary.Attributes["org_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccDBRPMappingResourceConfig("one_week", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_dbrp_mapping.test", "retention_policy", "one_week"),
+					resource.TestCheckResourceAttr("influxdb_dbrp_mapping.test", "default", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDBRPMappingResourceConfig(retentionPolicy string, isDefault bool) string {
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "test" {
+  name   = "test-dbrp-bucket"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_dbrp_mapping" "test" {
+  database         = "test_dbrp"
+  retention_policy = %[1]q
+  default          = %[2]t
+  bucket_id        = influxdb_bucket.test.id
+  org_id           = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+`, retentionPolicy, isDefault)
+}
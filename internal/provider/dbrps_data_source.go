@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DBRPsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DBRPsDataSource{}
+)
+
+// NewDBRPsDataSource is a helper function to simplify the provider implementation.
+func NewDBRPsDataSource() datasource.DataSource {
+	return &DBRPsDataSource{}
+}
+
+// DBRPsDataSource is the data source implementation.
+type DBRPsDataSource struct {
+	client influxdb2.Client
+}
+
+// DBRPsDataSourceModel describes the data source data model.
+type DBRPsDataSourceModel struct {
+	BucketID types.String       `tfsdk:"bucket_id"`
+	Database types.String       `tfsdk:"database"`
+	DBRPs    []DBRPMappingModel `tfsdk:"dbrps"`
+	OrgID    types.String       `tfsdk:"org_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *DBRPsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbrps"
+}
+
+// Schema defines the schema for the data source.
+func (d *DBRPsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "List DBRP mappings of an organization, optionally filtered by bucket or database.",
+
+		Attributes: map[string]schema.Attribute{
+			"bucket_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list mappings targeting this bucket ID.",
+			},
+			"database": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list mappings for this InfluxDB v1 database.",
+			},
+			"dbrps": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"bucket_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the bucket used as the target for the translation.",
+						},
+						"database": schema.StringAttribute{
+							Computed:    true,
+							Description: "The InfluxDB v1 database name.",
+						},
+						"default": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the mapping is the default retention policy for the database.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The DBRP mapping ID.",
+						},
+						"org_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The organization ID.",
+						},
+						"retention_policy": schema.StringAttribute{
+							Computed:    true,
+							Description: "The InfluxDB v1 retention policy name.",
+						},
+						"virtual": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates an autogenerated, virtual mapping based on the bucket name.",
+						},
+					},
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DBRPsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_dbrps")...)
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DBRPsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DBRPsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbrps, err := d.client.APIClient().GetDBRPs(ctx, &domain.GetDBRPsParams{
+		BucketID: state.BucketID.ValueStringPointer(),
+		Db:       state.Database.ValueStringPointer(),
+		OrgID:    state.OrgID.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Unable to list DBRP mappings",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeDbrp),
+		))
+
+		return
+	}
+
+	// Map response body to model
+	state.DBRPs = []DBRPMappingModel{}
+	if dbrps.Content != nil {
+		for _, dbrp := range *dbrps.Content {
+			state.DBRPs = append(state.DBRPs, convertDBRPToModel(&dbrp))
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDBRPsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccDBRPsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb_dbrps.by_database", "dbrps.#", "1"),
+					resource.TestCheckResourceAttr("data.influxdb_dbrps.by_database", "dbrps.0.database", "test_dbrps"),
+					resource.TestCheckResourceAttr("data.influxdb_dbrps.by_database", "dbrps.0.retention_policy", "autogen"),
+					resource.TestCheckResourceAttrPair("data.influxdb_dbrps.by_bucket", "dbrps.0.id", "influxdb_dbrp_mapping.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccDBRPsDataSourceConfig = `
+resource "influxdb_bucket" "test" {
+  name   = "test-dbrps-bucket"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+}
+
+resource "influxdb_dbrp_mapping" "test" {
+  database         = "test_dbrps"
+  retention_policy = "autogen"
+  default          = true
+  bucket_id        = influxdb_bucket.test.id
+  org_id           = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+}
+
+data "influxdb_dbrps" "by_database" {
+  org_id   = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  database = influxdb_dbrp_mapping.test.database
+}
+
+data "influxdb_dbrps" "by_bucket" {
+  org_id    = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  bucket_id = influxdb_dbrp_mapping.test.bucket_id
+}
+`
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &HealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &HealthDataSource{}
+)
+
+// NewHealthDataSource is a helper function to simplify the provider implementation.
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+// HealthDataSource is the data source implementation.
+type HealthDataSource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the data source type name.
+func (d *HealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *HealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieves the health of the InfluxDB instance. Does not require authentication, so it can be used with `allow_unauthenticated` before the instance is set up. Reading fails when the instance is unhealthy.",
+
+		Attributes: map[string]schema.Attribute{
+			"checks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The health checks of the dependencies of the instance.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "The message of the check.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the check.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the check (`pass` or `fail`).",
+						},
+					},
+				},
+			},
+			"commit": schema.StringAttribute{
+				Computed:    true,
+				Description: "The commit the InfluxDB instance was built from.",
+			},
+			"message": schema.StringAttribute{
+				Computed:    true,
+				Description: "The health message.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the service.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The health status (`pass` or `fail`).",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the InfluxDB instance.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+// The health endpoint does not require authentication.
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	health, err := d.client.Health(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading health",
+			"Could not read the health of the InfluxDB instance",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	// Map response body to model
+	state := convertHealthCheckToModel(health)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHealthDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccHealthDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb_health.test", "name", "influxdb"),
+					resource.TestCheckResourceAttr("data.influxdb_health.test", "status", "pass"),
+					resource.TestCheckResourceAttrSet("data.influxdb_health.test", "version"),
+				),
+			},
+			// The health does not require authentication
+			{
+				Config: testAccUnauthenticatedProviderConfig + testAccHealthDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb_health.test", "status", "pass"),
+				),
+			},
+		},
+	})
+}
+
+const testAccHealthDataSourceConfig = `
+data "influxdb_health" "test" {}
+`
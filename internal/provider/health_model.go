@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// HealthModel maps InfluxDB health schema data.
+type HealthModel struct {
+	Checks  []HealthCheckModel `tfsdk:"checks"`
+	Commit  types.String       `tfsdk:"commit"`
+	Message types.String       `tfsdk:"message"`
+	Name    types.String       `tfsdk:"name"`
+	Status  types.String       `tfsdk:"status"`
+	Version types.String       `tfsdk:"version"`
+}
+
+// HealthCheckModel maps InfluxDB health check schema data of a dependency.
+type HealthCheckModel struct {
+	Message types.String `tfsdk:"message"`
+	Name    types.String `tfsdk:"name"`
+	Status  types.String `tfsdk:"status"`
+}
+
+// ReadyModel maps InfluxDB readiness schema data.
+type ReadyModel struct {
+	Started types.String `tfsdk:"started"`
+	Status  types.String `tfsdk:"status"`
+	Up      types.String `tfsdk:"up"`
+}
+
+// convertHealthCheckToModel converts a domain.HealthCheck to HealthModel.
+func convertHealthCheckToModel(health *domain.HealthCheck) HealthModel {
+	checks := []HealthCheckModel{}
+	if health.Checks != nil {
+		for _, check := range *health.Checks {
+			checks = append(checks, HealthCheckModel{
+				Message: types.StringPointerValue(check.Message),
+				Name:    types.StringValue(check.Name),
+				Status:  types.StringValue(string(check.Status)),
+			})
+		}
+	}
+
+	return HealthModel{
+		Checks:  checks,
+		Commit:  types.StringPointerValue(health.Commit),
+		Message: types.StringPointerValue(health.Message),
+		Name:    types.StringValue(health.Name),
+		Status:  types.StringValue(string(health.Status)),
+		Version: types.StringPointerValue(health.Version),
+	}
+}
+
+// convertReadyToModel converts a domain.Ready to ReadyModel.
+func convertReadyToModel(ready *domain.Ready) ReadyModel {
+	model := ReadyModel{
+		Started: convertTimeToString(ready.Started),
+		Status:  types.StringNull(),
+		Up:      types.StringPointerValue(ready.Up),
+	}
+	if ready.Status != nil {
+		model.Status = types.StringValue(string(*ready.Status))
+	}
+
+	return model
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LabelAssignmentModel maps InfluxDB label assignment schema data.
+type LabelAssignmentModel struct {
+	Id           types.String   `tfsdk:"id"`
+	LabelID      types.String   `tfsdk:"label_id"`
+	ResourceID   types.String   `tfsdk:"resource_id"`
+	ResourceType types.String   `tfsdk:"resource_type"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// labelAssignmentResourceTypes are the resource types with a /labels sub-endpoint.
+// The type is also the path segment of the resource in the InfluxDB API.
+var labelAssignmentResourceTypes = []string{
+	"buckets",
+	"checks",
+	"dashboards",
+	"notificationEndpoints",
+	"notificationRules",
+	"scrapers",
+	"tasks",
+	"telegrafs",
+	"variables",
+}
+
+// labelAssignmentID returns the resource ID of a label assignment, <resource_type>/<resource_id>/<label_id>.
+func labelAssignmentID(resourceType string, resourceID string, labelID string) string {
+	return resourceType + "/" + resourceID + "/" + labelID
+}
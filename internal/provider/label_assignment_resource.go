@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &LabelAssignmentResource{}
+	_ resource.ResourceWithImportState = &LabelAssignmentResource{}
+)
+
+// NewLabelAssignmentResource is a helper function to simplify the provider implementation.
+func NewLabelAssignmentResource() resource.Resource {
+	return &LabelAssignmentResource{}
+}
+
+// LabelAssignmentResource defines the resource implementation.
+type LabelAssignmentResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *LabelAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label_assignment"
+}
+
+// Schema defines the schema for the resource.
+func (r *LabelAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Attaches a label to a resource. Do not combine it with the `label_ids` attribute of the same resource, as both manage the same labels. Existing assignments are imported using `<resource_type>/<resource_id>/<label_id>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The label assignment ID in the form `<resource_type>/<resource_id>/<label_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The label ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the resource to attach the label to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the resource to attach the label to. Valid values are `" + strings.Join(labelAssignmentResourceTypes, "`, `") + "`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(labelAssignmentResourceTypes...),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *LabelAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LabelAssignmentModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	labelMapping := domain.LabelMapping{
+		LabelID: plan.LabelID.ValueStringPointer(),
+	}
+
+	err := doAPIRequest(ctx, r.client, nethttp.MethodPost, labelsPath(plan.ResourceType.ValueString(), plan.ResourceID.ValueString()), labelMapping, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating label assignment",
+			"Could not create label assignment",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceType(plan.ResourceType.ValueString())),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Id = types.StringValue(labelAssignmentID(plan.ResourceType.ValueString(), plan.ResourceID.ValueString(), plan.LabelID.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *LabelAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state LabelAssignmentModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed labels of the resource from InfluxDB
+	var labels domain.LabelsResponse
+	err := doAPIRequest(ctx, r.client, nethttp.MethodGet, labelsPath(state.ResourceType.ValueString(), state.ResourceID.ValueString()), nil, &labels)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error getting labels",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceType(state.ResourceType.ValueString())),
+		))
+
+		return
+	}
+
+	// The label was removed outside of Terraform
+	if labels.Labels == nil || !slices.ContainsFunc(*labels.Labels, func(label domain.Label) bool {
+		return label.Id != nil && *label.Id == state.LabelID.ValueString()
+	}) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Id = types.StringValue(labelAssignmentID(state.ResourceType.ValueString(), state.ResourceID.ValueString(), state.LabelID.ValueString()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All attributes require replacement, so there is nothing to update in InfluxDB.
+func (r *LabelAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan LabelAssignmentModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *LabelAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LabelAssignmentModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing label assignment
+	err := doAPIRequest(ctx, r.client, nethttp.MethodDelete, labelsPath(state.ResourceType.ValueString(), state.ResourceID.ValueString())+"/"+state.LabelID.ValueString(), nil, nil)
+	if err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting label assignment",
+			"Could not delete label assignment",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceType(state.ResourceType.ValueString())),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *LabelAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_label_assignment")...)
+
+	r.client = client
+}
+
+// ImportState imports a label assignment using the import ID <resource_type>/<resource_id>/<label_id>.
+func (r *LabelAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: resource_type/resource_id/label_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	if !slices.Contains(labelAssignmentResourceTypes, idParts[0]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Resource type %q does not support labels. Valid values are: %s", idParts[0], strings.Join(labelAssignmentResourceTypes, ", ")),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_type"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("label_id"), idParts[2])...)
+}
+
+// labelsPath returns the API path of the labels of a resource
+func labelsPath(resourceType string, resourceID string) string {
+	return resourceType + "/" + resourceID + "/labels"
+}
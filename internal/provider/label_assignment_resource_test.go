@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLabelAssignmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccLabelAssignmentResourceConfig("test-label-assignment"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_label_assignment.test", "resource_type", "buckets"),
+					resource.TestCheckResourceAttrPair("influxdb_label_assignment.test", "resource_id", "influxdb_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair("influxdb_label_assignment.test", "label_id", "influxdb_label.test", "id"),
+					resource.TestCheckResourceAttrSet("influxdb_label_assignment.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_label_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: providerConfig + testAccLabelAssignmentResourceConfig("test-label-assignment-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("influxdb_label_assignment.test", "label_id", "influxdb_label.test", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccLabelAssignmentResourceConfig(labelName string) string {
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "test" {
+  name   = "test-label-assignment-bucket"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_label" "test" {
+  name   = %[1]q
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_label_assignment" "test" {
+  label_id      = influxdb_label.test.id
+  resource_type = "buckets"
+  resource_id   = influxdb_bucket.test.id
+}
+`, labelName)
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -76,6 +79,8 @@ func (d *LabelDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_label")...)
+
 	d.client = client
 }
 
@@ -100,10 +105,12 @@ func (d *LabelDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	label, err := d.client.LabelsAPI().FindLabelByID(ctx, labelID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Label not found",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading label",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeLabels),
+		))
 
 		return
 	}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
@@ -16,6 +17,12 @@ type LabelModel struct {
 	Properties types.Map    `tfsdk:"properties"`
 }
 
+// LabelResourceModel maps the label resource schema data, adding the timeouts to LabelModel.
+type LabelResourceModel struct {
+	LabelModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // convertLabelProperties converts domain.Label_Properties to types.Map
 // Returns a null map if properties are nil/empty, otherwise converts AdditionalProperties
 func convertLabelProperties(ctx context.Context, props *domain.Label_Properties) (types.Map, diag.Diagnostics) {
@@ -25,3 +32,55 @@ func convertLabelProperties(ctx context.Context, props *domain.Label_Properties)
 
 	return types.MapValueFrom(ctx, types.StringType, props.AdditionalProperties)
 }
+
+// convertLabelsToIDSet converts domain labels to a types.Set of label IDs
+// Returns a null set if no labels are attached
+func convertLabelsToIDSet(ctx context.Context, domainLabels *domain.Labels) (types.Set, diag.Diagnostics) {
+	if domainLabels == nil || len(*domainLabels) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	labelIDs := make([]string, 0, len(*domainLabels))
+	for _, label := range *domainLabels {
+		if label.Id != nil {
+			labelIDs = append(labelIDs, *label.Id)
+		}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, labelIDs)
+}
+
+// refreshedLabelIDs returns the label IDs read from InfluxDB when label_ids is managed by Terraform,
+// i.e. prior is not null. An unmanaged label_ids stays null so labels attached outside of the resource,
+// e.g. with influxdb_label_assignment, do not show as drift.
+func refreshedLabelIDs(prior types.Set, refreshed types.Set) types.Set {
+	if prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	return refreshed
+}
+
+// diffIDs returns the IDs that have to be added and removed to get from current to desired
+func diffIDs(current []string, desired []string) (toAdd []string, toRemove []string) {
+	currentSet := make(map[string]bool, len(current))
+	for _, id := range current {
+		currentSet[id] = true
+	}
+
+	desiredSet := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		desiredSet[id] = true
+		if !currentSet[id] {
+			toAdd = append(toAdd, id)
+		}
+	}
+
+	for _, id := range current {
+		if !desiredSet[id] {
+			toRemove = append(toRemove, id)
+		}
+	}
+
+	return toAdd, toRemove
+}
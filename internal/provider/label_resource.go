@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -19,6 +22,7 @@ var (
 	_ resource.Resource                = &LabelResource{}
 	_ resource.ResourceWithImportState = &LabelResource{}
 	_ resource.ResourceWithImportState = &LabelResource{}
+	_ resource.ResourceWithModifyPlan  = &LabelResource{}
 )
 
 // NewLabelResource is a helper function to simplify the provider implementation.
@@ -55,8 +59,12 @@ func (r *LabelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "A label name.",
 			},
 			"org_id": schema.StringAttribute{
-				Required:    true,
-				Description: "The organization ID.",
+				Computed:    true,
+				Optional:    true,
+				Description: "The organization ID. Defaults to the default organization of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"properties": schema.MapAttribute{
 				Optional:    true,
@@ -64,12 +72,21 @@ func (r *LabelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "The key-value pairs to associate with this label.",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
+// ModifyPlan defaults org_id to the default organization of the provider.
+func (r *LabelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *LabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan LabelModel
+	var plan LabelResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -77,6 +94,15 @@ func (r *LabelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	createLabel := domain.LabelCreateRequest{
 		Name:  plan.Name.ValueString(),
@@ -98,10 +124,12 @@ func (r *LabelResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	createLabelResponse, err := r.client.LabelsAPI().CreateLabel(ctx, &createLabel)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error creating label",
-			"Could not create label, unexpected error: "+err.Error(),
-		)
+			"Could not create label",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeLabels),
+		))
 
 		return
 	}
@@ -128,7 +156,7 @@ func (r *LabelResource) Create(ctx context.Context, req resource.CreateRequest,
 // Read refreshes the Terraform state with the latest data.
 func (r *LabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state LabelModel
+	var state LabelResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -136,13 +164,29 @@ func (r *LabelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed label value from InfluxDB
 	label, err := r.client.LabelsAPI().FindLabelByID(ctx, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Label not found",
-			err.Error(),
-		)
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading label",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeLabels),
+		))
 
 		return
 	}
@@ -155,7 +199,7 @@ func (r *LabelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	state = LabelModel{
+	state.LabelModel = LabelModel{
 		Id:         types.StringValue(*label.Id),
 		Name:       types.StringValue(*label.Name),
 		OrgID:      types.StringValue(*label.OrgID),
@@ -171,8 +215,8 @@ func (r *LabelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *LabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan LabelModel
-	var state LabelModel
+	var plan LabelResourceModel
+	var state LabelResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -180,6 +224,15 @@ func (r *LabelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Read current state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -226,10 +279,12 @@ func (r *LabelResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update existing label
 	apiResponse, err := r.client.LabelsAPI().UpdateLabel(ctx, &updateLabel)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error updating label",
-			"Could not update label, unexpected error: "+err.Error(),
-		)
+			"Could not update label",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeLabels),
+		))
 		return
 	}
 
@@ -261,7 +316,7 @@ func (r *LabelResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *LabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state LabelModel
+	var state LabelResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -269,13 +324,24 @@ func (r *LabelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing label
 	err := r.client.LabelsAPI().DeleteLabelWithID(ctx, *state.Id.ValueStringPointer())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error deleting label",
-			"Could not delete label, unexpected error: "+err.Error(),
-		)
+			"Could not delete label",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeLabels),
+		))
 
 		return
 	}
@@ -298,6 +364,8 @@ func (r *LabelResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_label")...)
+
 	r.client = client
 }
 
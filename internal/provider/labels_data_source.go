@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -88,6 +91,8 @@ func (d *LabelsDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_labels")...)
+
 	d.client = client
 }
 
@@ -97,10 +102,12 @@ func (d *LabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	labels, err := d.client.LabelsAPI().GetLabels(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Unable to list labels",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeLabels),
+		))
 
 		return
 	}
@@ -60,25 +60,25 @@ func testAccLabelsDataSourceConfig() string {
 	return fmt.Sprintf(`
 resource "influxdb_label" "test1" {
   name   = "test-labels-1"
-  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  org_id = %[1]q
 }
 
 resource "influxdb_label" "test2" {
   name   = "test-labels-2"
-  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  org_id = %[1]q
 }
 
 data "influxdb_labels" "test" {
   depends_on = [influxdb_label.test1, influxdb_label.test2]
 }
-`)
+`, os.Getenv("INFLUXDB_ORG_ID"))
 }
 
 func testAccLabelsDataSourceWithPropertiesConfig() string {
 	return fmt.Sprintf(`
 resource "influxdb_label" "test1" {
   name   = "test-labels-props-1"
-  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  org_id = %[1]q
   properties = {
     "environment" = "test"
     "team"        = "qa"
@@ -87,7 +87,7 @@ resource "influxdb_label" "test1" {
 
 resource "influxdb_label" "test2" {
   name   = "test-labels-props-2"
-  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  org_id = %[1]q
   properties = {
     "environment" = "production"
     "priority"    = "high"
@@ -97,5 +97,5 @@ resource "influxdb_label" "test2" {
 data "influxdb_labels" "test" {
   depends_on = [influxdb_label.test1, influxdb_label.test2]
 }
-`)
+`, os.Getenv("INFLUXDB_ORG_ID"))
 }
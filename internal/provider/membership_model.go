@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// membershipModel maps InfluxDB organization and bucket member and owner schema data.
+// The attributes are read and saved one by one, as the name of the TargetID attribute
+// differs between organizations and buckets.
+type membershipModel struct {
+	Id       types.String
+	TargetID types.String
+	Timeouts timeouts.Value
+	UserID   types.String
+	UserName types.String
+}
+
+// membershipData is the Terraform plan or state of a member or owner resource.
+type membershipData interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// membershipID returns the resource ID of a membership, <target_id>/<user_id>.
+func membershipID(targetID string, userID string) string {
+	return targetID + "/" + userID
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// membershipTarget adds, lists and removes the members or owners of an InfluxDB object
+// that users belong to, i.e. an organization or a bucket.
+type membershipTarget interface {
+	// addUser adds the user to the object in the role and returns the added user.
+	addUser(ctx context.Context, client influxdb2.Client, role string, targetID string, userID string) (*domain.UserResponse, error)
+	// listUsers returns the users of the object in the role.
+	listUsers(ctx context.Context, client influxdb2.Client, role string, targetID string) ([]domain.UserResponse, error)
+	// removeUser removes the user from the object in the role.
+	removeUser(ctx context.Context, client influxdb2.Client, role string, targetID string, userID string) error
+}
+
+// membershipResource implements the CRUD of a single member or owner edge between a user
+// and the object of target. The member and owner resources of organizations and buckets
+// embed it and only define their type name and schema.
+type membershipResource struct {
+	client influxdb2.Client
+	role   string
+	target membershipTarget
+
+	// targetAttribute is the name of the attribute holding the object ID, e.g. org_id
+	targetAttribute string
+	// targetName is the name of the object type used in diagnostics, e.g. organization
+	targetName string
+	// targetResourceType is the resource type of the object in InfluxDB permissions
+	targetResourceType domain.ResourceType
+	// typeName is the resource type name without the provider prefix, e.g. org_member
+	typeName string
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *membershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan membershipModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	user, err := r.target.addUser(ctx, r.client, r.role, plan.TargetID.ValueString(), plan.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			fmt.Sprintf("Error adding %s %s", r.targetName, r.role),
+			fmt.Sprintf("Could not add %s %s", r.targetName, r.role),
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", r.targetResourceType),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Id = types.StringValue(membershipID(plan.TargetID.ValueString(), plan.UserID.ValueString()))
+	plan.UserName = types.StringValue(user.Name)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *membershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state membershipModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed members or owners from InfluxDB
+	users, err := r.target.listUsers(ctx, r.client, r.role, state.TargetID.ValueString())
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			fmt.Sprintf("Error getting %s %ss", r.targetName, r.role),
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", r.targetResourceType),
+		))
+
+		return
+	}
+
+	user := findUserResponse(users, state.UserID.ValueString())
+
+	// The user was removed outside of Terraform
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Id = types.StringValue(membershipID(state.TargetID.ValueString(), state.UserID.ValueString()))
+	state.UserName = types.StringValue(user.Name)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All attributes require replacement, so there is nothing to update in InfluxDB.
+func (r *membershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan membershipModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(r.setModel(ctx, &resp.State, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *membershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state membershipModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(r.getModel(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Remove existing member or owner
+	err := r.target.removeUser(ctx, r.client, r.role, state.TargetID.ValueString(), state.UserID.ValueString())
+	if err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			fmt.Sprintf("Error removing %s %s", r.targetName, r.role),
+			fmt.Sprintf("Could not remove %s %s", r.targetName, r.role),
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", r.targetResourceType),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *membershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_"+r.typeName)...)
+
+	r.client = client
+}
+
+func (r *membershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	targetID, userID, ok := strings.Cut(req.ID, "/")
+	if !ok || targetID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s/user_id. Got: %q", r.targetAttribute, req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.targetAttribute), targetID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+// getModel reads the plan or state data into model
+func (r *membershipResource) getModel(ctx context.Context, data membershipData, model *membershipModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(data.GetAttribute(ctx, path.Root("id"), &model.Id)...)
+	diags.Append(data.GetAttribute(ctx, path.Root(r.targetAttribute), &model.TargetID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("timeouts"), &model.Timeouts)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("user_id"), &model.UserID)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("user_name"), &model.UserName)...)
+
+	return diags
+}
+
+// setModel saves model into the Terraform state
+func (r *membershipResource) setModel(ctx context.Context, state *tfsdk.State, model membershipModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), model.Id)...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.targetAttribute), model.TargetID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("timeouts"), model.Timeouts)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("user_id"), model.UserID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("user_name"), model.UserName)...)
+
+	return diags
+}
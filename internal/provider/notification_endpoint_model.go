@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// NotificationEndpointModel maps InfluxDB notification endpoint schema data.
+type NotificationEndpointModel struct {
+	CreatedAt   types.String                        `tfsdk:"created_at"`
+	Description types.String                        `tfsdk:"description"`
+	HTTP        *NotificationEndpointHTTPModel      `tfsdk:"http"`
+	Id          types.String                        `tfsdk:"id"`
+	Name        types.String                        `tfsdk:"name"`
+	OrgID       types.String                        `tfsdk:"org_id"`
+	PagerDuty   *NotificationEndpointPagerDutyModel `tfsdk:"pagerduty"`
+	Slack       *NotificationEndpointSlackModel     `tfsdk:"slack"`
+	Status      types.String                        `tfsdk:"status"`
+	Timeouts    timeouts.Value                      `tfsdk:"timeouts"`
+	Type        types.String                        `tfsdk:"type"`
+	UpdatedAt   types.String                        `tfsdk:"updated_at"`
+}
+
+// NotificationEndpointHTTPModel maps InfluxDB HTTP notification endpoint schema data.
+type NotificationEndpointHTTPModel struct {
+	AuthMethod      types.String `tfsdk:"auth_method"`
+	ContentTemplate types.String `tfsdk:"content_template"`
+	Headers         types.Map    `tfsdk:"headers"`
+	Method          types.String `tfsdk:"method"`
+	Password        types.String `tfsdk:"password"`
+	Token           types.String `tfsdk:"token"`
+	URL             types.String `tfsdk:"url"`
+	Username        types.String `tfsdk:"username"`
+}
+
+// NotificationEndpointSlackModel maps InfluxDB Slack notification endpoint schema data.
+type NotificationEndpointSlackModel struct {
+	Token types.String `tfsdk:"token"`
+	URL   types.String `tfsdk:"url"`
+}
+
+// NotificationEndpointPagerDutyModel maps InfluxDB PagerDuty notification endpoint schema data.
+type NotificationEndpointPagerDutyModel struct {
+	ClientURL  types.String `tfsdk:"client_url"`
+	RoutingKey types.String `tfsdk:"routing_key"`
+}
+
+// notificationEndpointJSON is the wire format of a notification endpoint.
+// domain.NotificationEndpoint wraps the endpoint variants in an untyped discriminator
+// that encoding/json cannot round-trip, so the variant fields are flattened here.
+type notificationEndpointJSON struct {
+	domain.NotificationEndpointBase
+
+	// http
+	AuthMethod      *string           `json:"authMethod,omitempty"`
+	ContentTemplate *string           `json:"contentTemplate,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Method          *string           `json:"method,omitempty"`
+	Password        *string           `json:"password,omitempty"`
+	Username        *string           `json:"username,omitempty"`
+
+	// http and slack
+	Token *string `json:"token,omitempty"`
+	Url   *string `json:"url,omitempty"`
+
+	// pagerduty
+	ClientURL  *string `json:"clientURL,omitempty"`
+	RoutingKey *string `json:"routingKey,omitempty"`
+}
+
+// convertModelToNotificationEndpoint converts a NotificationEndpointModel to its wire format.
+func convertModelToNotificationEndpoint(ctx context.Context, plan NotificationEndpointModel) (notificationEndpointJSON, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	status := domain.NotificationEndpointBaseStatus(plan.Status.ValueString())
+
+	endpoint := notificationEndpointJSON{
+		NotificationEndpointBase: domain.NotificationEndpointBase{
+			Description: plan.Description.ValueStringPointer(),
+			Name:        plan.Name.ValueString(),
+			OrgID:       plan.OrgID.ValueStringPointer(),
+			Status:      &status,
+			Type:        domain.NotificationEndpointType(plan.Type.ValueString()),
+		},
+	}
+
+	switch {
+	case plan.HTTP != nil:
+		endpoint.AuthMethod = plan.HTTP.AuthMethod.ValueStringPointer()
+		endpoint.ContentTemplate = plan.HTTP.ContentTemplate.ValueStringPointer()
+		endpoint.Method = plan.HTTP.Method.ValueStringPointer()
+		endpoint.Password = plan.HTTP.Password.ValueStringPointer()
+		endpoint.Token = plan.HTTP.Token.ValueStringPointer()
+		endpoint.Url = plan.HTTP.URL.ValueStringPointer()
+		endpoint.Username = plan.HTTP.Username.ValueStringPointer()
+		if !plan.HTTP.Headers.IsNull() && !plan.HTTP.Headers.IsUnknown() {
+			diags.Append(plan.HTTP.Headers.ElementsAs(ctx, &endpoint.Headers, false)...)
+		}
+	case plan.Slack != nil:
+		endpoint.Token = plan.Slack.Token.ValueStringPointer()
+		endpoint.Url = plan.Slack.URL.ValueStringPointer()
+	case plan.PagerDuty != nil:
+		endpoint.ClientURL = plan.PagerDuty.ClientURL.ValueStringPointer()
+		endpoint.RoutingKey = plan.PagerDuty.RoutingKey.ValueStringPointer()
+	}
+
+	return endpoint, diags
+}
+
+// convertNotificationEndpointToModel converts a notification endpoint to NotificationEndpointModel.
+// InfluxDB keeps credentials in its secret store and only returns references to them,
+// so secret values are carried over from prior.
+func convertNotificationEndpointToModel(ctx context.Context, endpoint notificationEndpointJSON, prior NotificationEndpointModel) (NotificationEndpointModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	status := types.StringNull()
+	if endpoint.Status != nil {
+		status = types.StringValue(string(*endpoint.Status))
+	}
+
+	model := NotificationEndpointModel{
+		CreatedAt:   convertTimeToString(endpoint.CreatedAt),
+		Description: types.StringPointerValue(endpoint.Description),
+		Id:          types.StringPointerValue(endpoint.Id),
+		Name:        types.StringValue(endpoint.Name),
+		OrgID:       types.StringPointerValue(endpoint.OrgID),
+		Status:      status,
+		Timeouts:    prior.Timeouts,
+		Type:        types.StringValue(string(endpoint.Type)),
+		UpdatedAt:   convertTimeToString(endpoint.UpdatedAt),
+	}
+
+	switch endpoint.Type {
+	case domain.NotificationEndpointTypeHttp:
+		headers := types.MapNull(types.StringType)
+		if len(endpoint.Headers) > 0 {
+			var headerDiags diag.Diagnostics
+			headers, headerDiags = types.MapValueFrom(ctx, types.StringType, endpoint.Headers)
+			diags.Append(headerDiags...)
+		}
+
+		model.HTTP = &NotificationEndpointHTTPModel{
+			AuthMethod:      types.StringPointerValue(endpoint.AuthMethod),
+			ContentTemplate: types.StringPointerValue(endpoint.ContentTemplate),
+			Headers:         headers,
+			Method:          types.StringPointerValue(endpoint.Method),
+			Password:        types.StringNull(),
+			Token:           types.StringNull(),
+			URL:             types.StringPointerValue(endpoint.Url),
+			Username:        types.StringNull(),
+		}
+		if prior.HTTP != nil {
+			model.HTTP.Password = prior.HTTP.Password
+			model.HTTP.Token = prior.HTTP.Token
+			model.HTTP.Username = prior.HTTP.Username
+		}
+	case domain.NotificationEndpointTypeSlack:
+		model.Slack = &NotificationEndpointSlackModel{
+			Token: types.StringNull(),
+			URL:   types.StringPointerValue(endpoint.Url),
+		}
+		if prior.Slack != nil {
+			model.Slack.Token = prior.Slack.Token
+		}
+	case domain.NotificationEndpointTypePagerduty:
+		model.PagerDuty = &NotificationEndpointPagerDutyModel{
+			ClientURL:  types.StringPointerValue(endpoint.ClientURL),
+			RoutingKey: types.StringNull(),
+		}
+		if prior.PagerDuty != nil {
+			model.PagerDuty.RoutingKey = prior.PagerDuty.RoutingKey
+		}
+	}
+
+	return model, diags
+}
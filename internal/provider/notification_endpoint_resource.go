@@ -0,0 +1,494 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &NotificationEndpointResource{}
+	_ resource.ResourceWithImportState    = &NotificationEndpointResource{}
+	_ resource.ResourceWithValidateConfig = &NotificationEndpointResource{}
+)
+
+// NewNotificationEndpointResource is a helper function to simplify the provider implementation.
+func NewNotificationEndpointResource() resource.Resource {
+	return &NotificationEndpointResource{}
+}
+
+// NotificationEndpointResource defines the resource implementation.
+type NotificationEndpointResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *NotificationEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_endpoint"
+}
+
+// Schema defines the schema for the resource.
+func (r *NotificationEndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a notification endpoint. Notification rules send check statuses to an HTTP, Slack or PagerDuty endpoint. Credentials are stored in the InfluxDB secret store and are not read back, so changes made outside of Terraform are not detected.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the notification endpoint was created.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the notification endpoint.",
+			},
+			"http": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The HTTP endpoint settings. Required when `type` is `http`.",
+				Attributes: map[string]schema.Attribute{
+					"auth_method": schema.StringAttribute{
+						Computed:    true,
+						Optional:    true,
+						Description: "The authentication method (`none`, `basic` or `bearer`). Defaults to `none`.",
+						Default:     stringdefault.StaticString(string(domain.HTTPNotificationEndpointAuthMethodNone)),
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"none", "basic", "bearer"}...),
+						},
+					},
+					"content_template": schema.StringAttribute{
+						Optional:    true,
+						Description: "The template used to generate the request body.",
+					},
+					"headers": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The HTTP headers to send with each request.",
+					},
+					"method": schema.StringAttribute{
+						Computed:    true,
+						Optional:    true,
+						Description: "The HTTP method (`POST`, `GET` or `PUT`). Defaults to `POST`.",
+						Default:     stringdefault.StaticString(string(domain.HTTPNotificationEndpointMethodPOST)),
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"POST", "GET", "PUT"}...),
+						},
+					},
+					"password": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The password used when `auth_method` is `basic`.",
+					},
+					"token": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The token used when `auth_method` is `bearer`.",
+					},
+					"url": schema.StringAttribute{
+						Required:    true,
+						Description: "The URL to send notifications to.",
+					},
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Description: "The username used when `auth_method` is `basic`.",
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The notification endpoint ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the notification endpoint.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pagerduty": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The PagerDuty endpoint settings. Required when `type` is `pagerduty`.",
+				Attributes: map[string]schema.Attribute{
+					"client_url": schema.StringAttribute{
+						Optional:    true,
+						Description: "The URL linked from PagerDuty incidents back to InfluxDB.",
+					},
+					"routing_key": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The PagerDuty Events API v2 integration routing key.",
+					},
+				},
+			},
+			"slack": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The Slack endpoint settings. Required when `type` is `slack`.",
+				Attributes: map[string]schema.Attribute{
+					"token": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The Slack app token. Not needed when `url` is an incoming webhook.",
+					},
+					"url": schema.StringAttribute{
+						Optional:    true,
+						Description: "The Slack incoming webhook URL.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The status of the notification endpoint (`active` or `inactive`).",
+				Default:     stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"active", "inactive"}...),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the notification endpoint (`http`, `slack` or `pagerduty`).",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"http", "slack", "pagerduty"}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the notification endpoint was last updated.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates that the settings block matches the endpoint type.
+func (r *NotificationEndpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The attributes are read one by one, as the settings blocks may be unknown, e.g. when set from a module output
+	var endpointType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &endpointType)...)
+	if resp.Diagnostics.HasError() || endpointType.IsUnknown() || endpointType.IsNull() {
+		return
+	}
+
+	for _, block := range []string{"http", "slack", "pagerduty"} {
+		var settings types.Object
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block), &settings)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if settings.IsUnknown() {
+			continue
+		}
+
+		set := !settings.IsNull()
+		if block == endpointType.ValueString() && !set {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block),
+				"Missing notification endpoint settings",
+				fmt.Sprintf("The %q attribute must be set when type is %q.", block, endpointType.ValueString()),
+			)
+		}
+		if block != endpointType.ValueString() && set {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block),
+				"Invalid notification endpoint settings",
+				fmt.Sprintf("The %q attribute cannot be set when type is %q.", block, endpointType.ValueString()),
+			)
+		}
+	}
+
+	var http types.Object
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http"), &http)...)
+	if resp.Diagnostics.HasError() || http.IsUnknown() || http.IsNull() {
+		return
+	}
+
+	var authMethod, username, password, token types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http").AtName("auth_method"), &authMethod)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http").AtName("username"), &username)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http").AtName("password"), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http").AtName("token"), &token)...)
+	if resp.Diagnostics.HasError() || authMethod.IsUnknown() {
+		return
+	}
+
+	switch authMethod.ValueString() {
+	case "basic":
+		if username.IsNull() || password.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http").AtName("auth_method"),
+				"Missing HTTP credentials",
+				"The username and password attributes must be set when auth_method is \"basic\".",
+			)
+		}
+	case "bearer":
+		if token.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http").AtName("auth_method"),
+				"Missing HTTP credentials",
+				"The token attribute must be set when auth_method is \"bearer\".",
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *NotificationEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationEndpointModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createEndpoint, diags := convertModelToNotificationEndpoint(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResponse notificationEndpointJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodPost, "notificationEndpoints", createEndpoint, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating notification endpoint",
+			"Could not create notification endpoint",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeNotificationEndpoints),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state, diags := convertNotificationEndpointToModel(ctx, apiResponse, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *NotificationEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state NotificationEndpointModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed notification endpoint value from InfluxDB
+	var endpoint notificationEndpointJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodGet, "notificationEndpoints/"+state.Id.ValueString(), nil, &endpoint)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading notification endpoint",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeNotificationEndpoints),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	newState, diags := convertNotificationEndpointToModel(ctx, endpoint, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *NotificationEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationEndpointModel
+	var state NotificationEndpointModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateEndpoint, diags := convertModelToNotificationEndpoint(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateEndpoint.Id = state.Id.ValueStringPointer()
+
+	// Update existing notification endpoint
+	var apiResponse notificationEndpointJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodPut, "notificationEndpoints/"+state.Id.ValueString(), updateEndpoint, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating notification endpoint",
+			"Could not update notification endpoint",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeNotificationEndpoints),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newState, diags := convertNotificationEndpointToModel(ctx, apiResponse, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *NotificationEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationEndpointModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing notification endpoint
+	err := r.client.APIClient().DeleteNotificationEndpointsID(ctx, &domain.DeleteNotificationEndpointsIDAllParams{
+		EndpointID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting notification endpoint",
+			"Could not delete notification endpoint",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeNotificationEndpoints),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NotificationEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_notification_endpoint")...)
+
+	r.client = client
+}
+
+func (r *NotificationEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
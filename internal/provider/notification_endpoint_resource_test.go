@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationEndpointResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNotificationEndpointResourceHTTPConfig("test-endpoint", "POST"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_notification_endpoint.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "name", "test-endpoint"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "type", "http"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "status", "active"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "http.method", "POST"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "http.auth_method", "bearer"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "http.headers.X-Source", "influxdb"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "influxdb_notification_endpoint.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http.token"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNotificationEndpointResourceHTTPConfig("test-endpoint-updated", "PUT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "name", "test-endpoint-updated"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.test", "http.method", "PUT"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNotificationEndpointResourceSlackAndPagerDuty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNotificationEndpointResourceSlackAndPagerDutyConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_notification_endpoint.slack", "id"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.slack", "type", "slack"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.slack", "slack.url", "https://hooks.slack.com/services/x/y/z"),
+					resource.TestCheckResourceAttrSet("influxdb_notification_endpoint.pagerduty", "id"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.pagerduty", "type", "pagerduty"),
+					resource.TestCheckResourceAttr("influxdb_notification_endpoint.pagerduty", "pagerduty.client_url", "https://influxdb.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationEndpointResourceHTTPConfig(name string, method string) string {
+	return fmt.Sprintf(`
+resource "influxdb_notification_endpoint" "test" {
+  name   = %[1]q
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  type   = "http"
+  http = {
+    url         = "https://alerts.example.com/influxdb"
+    method      = %[2]q
+    auth_method = "bearer"
+    token       = "secret-token"
+    headers = {
+      "X-Source" = "influxdb"
+    }
+  }
+}
+`, name, method)
+}
+
+func testAccNotificationEndpointResourceSlackAndPagerDutyConfig() string {
+	return `
+resource "influxdb_notification_endpoint" "slack" {
+  name   = "test-slack-endpoint"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  type   = "slack"
+  slack = {
+    url = "https://hooks.slack.com/services/x/y/z"
+  }
+}
+
+resource "influxdb_notification_endpoint" "pagerduty" {
+  name   = "test-pagerduty-endpoint"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  type   = "pagerduty"
+  pagerduty = {
+    client_url  = "https://influxdb.example.com"
+    routing_key = "routing-key"
+  }
+}
+`
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// NotificationRuleModel maps InfluxDB notification rule schema data.
+type NotificationRuleModel struct {
+	Channel         types.String                   `tfsdk:"channel"`
+	CreatedAt       types.String                   `tfsdk:"created_at"`
+	Description     types.String                   `tfsdk:"description"`
+	EndpointID      types.String                   `tfsdk:"endpoint_id"`
+	Every           types.String                   `tfsdk:"every"`
+	Id              types.String                   `tfsdk:"id"`
+	LastRunError    types.String                   `tfsdk:"last_run_error"`
+	LastRunStatus   types.String                   `tfsdk:"last_run_status"`
+	MessageTemplate types.String                   `tfsdk:"message_template"`
+	Name            types.String                   `tfsdk:"name"`
+	Offset          types.String                   `tfsdk:"offset"`
+	OrgID           types.String                   `tfsdk:"org_id"`
+	OwnerID         types.String                   `tfsdk:"owner_id"`
+	Status          types.String                   `tfsdk:"status"`
+	StatusRules     []NotificationRuleStatusModel  `tfsdk:"status_rules"`
+	TagRules        []NotificationRuleTagRuleModel `tfsdk:"tag_rules"`
+	TaskID          types.String                   `tfsdk:"task_id"`
+	Timeouts        timeouts.Value                 `tfsdk:"timeouts"`
+	UpdatedAt       types.String                   `tfsdk:"updated_at"`
+}
+
+// NotificationRuleStatusModel maps InfluxDB notification rule status rule schema data.
+type NotificationRuleStatusModel struct {
+	CurrentLevel  types.String `tfsdk:"current_level"`
+	PreviousLevel types.String `tfsdk:"previous_level"`
+}
+
+// NotificationRuleTagRuleModel maps InfluxDB notification rule tag rule schema data.
+type NotificationRuleTagRuleModel struct {
+	Key      types.String `tfsdk:"key"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+// notificationRuleStatusLevels lists the status levels a notification rule can match.
+var notificationRuleStatusLevels = []string{"CRIT", "WARN", "INFO", "OK", "UNKNOWN", "ANY"}
+
+// notificationRuleJSON is the wire format of a notification rule.
+// domain.NotificationRule wraps the rule variants in an untyped discriminator
+// that encoding/json cannot round-trip, so the variant fields are flattened here.
+type notificationRuleJSON struct {
+	domain.NotificationRuleBase
+
+	Type string `json:"type"`
+
+	// slack and pagerduty
+	MessageTemplate *string `json:"messageTemplate,omitempty"`
+
+	// slack
+	Channel *string `json:"channel,omitempty"`
+}
+
+// convertModelToNotificationRule converts a NotificationRuleModel to the wire format of a
+// rule sending to an endpoint of the given type.
+func convertModelToNotificationRule(plan NotificationRuleModel, endpointType string) notificationRuleJSON {
+	statusRules := make([]domain.StatusRule, 0, len(plan.StatusRules))
+	for _, statusRule := range plan.StatusRules {
+		currentLevel := domain.RuleStatusLevel(statusRule.CurrentLevel.ValueString())
+		rule := domain.StatusRule{
+			CurrentLevel: &currentLevel,
+		}
+		if !statusRule.PreviousLevel.IsNull() {
+			previousLevel := domain.RuleStatusLevel(statusRule.PreviousLevel.ValueString())
+			rule.PreviousLevel = &previousLevel
+		}
+		statusRules = append(statusRules, rule)
+	}
+
+	tagRules := make([]domain.TagRule, 0, len(plan.TagRules))
+	for _, tagRule := range plan.TagRules {
+		operator := domain.TagRuleOperator(tagRule.Operator.ValueString())
+		tagRules = append(tagRules, domain.TagRule{
+			Key:      tagRule.Key.ValueStringPointer(),
+			Operator: &operator,
+			Value:    tagRule.Value.ValueStringPointer(),
+		})
+	}
+
+	rule := notificationRuleJSON{
+		NotificationRuleBase: domain.NotificationRuleBase{
+			Description: plan.Description.ValueStringPointer(),
+			EndpointID:  plan.EndpointID.ValueString(),
+			Every:       plan.Every.ValueStringPointer(),
+			Name:        plan.Name.ValueString(),
+			OrgID:       plan.OrgID.ValueString(),
+			Status:      domain.TaskStatusType(plan.Status.ValueString()),
+			StatusRules: statusRules,
+			TagRules:    &tagRules,
+		},
+		Type: endpointType,
+	}
+
+	if !plan.Offset.IsNull() && !plan.Offset.IsUnknown() {
+		rule.Offset = plan.Offset.ValueStringPointer()
+	}
+	if endpointType != string(domain.NotificationEndpointTypeHttp) {
+		messageTemplate := plan.MessageTemplate.ValueString()
+		rule.MessageTemplate = &messageTemplate
+	}
+	if endpointType == string(domain.NotificationEndpointTypeSlack) {
+		rule.Channel = plan.Channel.ValueStringPointer()
+	}
+
+	return rule
+}
+
+// convertNotificationRuleToModel converts a notification rule to NotificationRuleModel.
+func convertNotificationRuleToModel(rule notificationRuleJSON) NotificationRuleModel {
+	var statusRules []NotificationRuleStatusModel
+	for _, statusRule := range rule.StatusRules {
+		statusRules = append(statusRules, NotificationRuleStatusModel{
+			CurrentLevel:  convertRuleStatusLevelToString(statusRule.CurrentLevel),
+			PreviousLevel: convertRuleStatusLevelToString(statusRule.PreviousLevel),
+		})
+	}
+
+	var tagRules []NotificationRuleTagRuleModel
+	if rule.TagRules != nil {
+		for _, tagRule := range *rule.TagRules {
+			operator := types.StringNull()
+			if tagRule.Operator != nil {
+				operator = types.StringValue(string(*tagRule.Operator))
+			}
+			tagRules = append(tagRules, NotificationRuleTagRuleModel{
+				Key:      types.StringPointerValue(tagRule.Key),
+				Operator: operator,
+				Value:    types.StringPointerValue(tagRule.Value),
+			})
+		}
+	}
+
+	lastRunStatus := types.StringNull()
+	if rule.LastRunStatus != nil {
+		lastRunStatus = types.StringValue(string(*rule.LastRunStatus))
+	}
+
+	channel := types.StringNull()
+	if rule.Channel != nil && *rule.Channel != "" {
+		channel = types.StringValue(*rule.Channel)
+	}
+
+	messageTemplate := types.StringNull()
+	if rule.MessageTemplate != nil && *rule.MessageTemplate != "" {
+		messageTemplate = types.StringValue(*rule.MessageTemplate)
+	}
+
+	return NotificationRuleModel{
+		Channel:         channel,
+		CreatedAt:       convertTimeToString(rule.CreatedAt),
+		Description:     types.StringPointerValue(rule.Description),
+		EndpointID:      types.StringValue(rule.EndpointID),
+		Every:           types.StringPointerValue(rule.Every),
+		Id:              types.StringPointerValue(rule.Id),
+		LastRunError:    types.StringPointerValue(rule.LastRunError),
+		LastRunStatus:   lastRunStatus,
+		MessageTemplate: messageTemplate,
+		Name:            types.StringValue(rule.Name),
+		Offset:          types.StringPointerValue(rule.Offset),
+		OrgID:           types.StringValue(rule.OrgID),
+		OwnerID:         types.StringPointerValue(rule.OwnerID),
+		Status:          types.StringValue(string(rule.Status)),
+		StatusRules:     statusRules,
+		TagRules:        tagRules,
+		TaskID:          types.StringPointerValue(rule.TaskID),
+		UpdatedAt:       convertTimeToString(rule.UpdatedAt),
+	}
+}
+
+// convertRuleStatusLevelToString converts a *domain.RuleStatusLevel to types.String.
+func convertRuleStatusLevelToString(level *domain.RuleStatusLevel) types.String {
+	if level == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(*level))
+}
@@ -0,0 +1,491 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NotificationRuleResource{}
+	_ resource.ResourceWithImportState = &NotificationRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationRuleResource{}
+)
+
+// NewNotificationRuleResource is a helper function to simplify the provider implementation.
+func NewNotificationRuleResource() resource.Resource {
+	return &NotificationRuleResource{}
+}
+
+// NotificationRuleResource defines the resource implementation.
+type NotificationRuleResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *NotificationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *NotificationRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a notification rule. A notification rule periodically looks for check statuses matching its status and tag rules and sends them to a notification endpoint. The rule type follows the type of the referenced endpoint.",
+
+		Attributes: map[string]schema.Attribute{
+			"channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Slack channel to post to. Only used with `slack` endpoints.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the notification rule was created.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the notification rule.",
+			},
+			"endpoint_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the notification endpoint to send notifications to.",
+			},
+			"every": schema.StringAttribute{
+				Required:    true,
+				Description: "The interval [duration literal](https://docs.influxdata.com/influxdb/v2/reference/glossary/#duration) at which the rule runs.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The notification rule ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_run_error": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error from the latest run, if any.",
+			},
+			"last_run_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the latest run.",
+			},
+			"message_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "The template used to generate the notification message, e.g. `Notification Rule: ${ r._notification_rule_name } triggered by check: ${ r._check_name }: ${ r._message }`. Required with `slack` and `pagerduty` endpoints.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the notification rule.",
+			},
+			"offset": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The [duration](https://docs.influxdata.com/influxdb/v2/reference/glossary/#duration) to delay after the scheduled time before running the rule.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the user who owns the notification rule.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The status of the notification rule (`active` or `inactive`).",
+				Default:     stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"active", "inactive"}...),
+				},
+			},
+			"status_rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The check statuses to notify on. Set `previous_level` to only notify when the status changes from that level.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"current_level": schema.StringAttribute{
+							Required:    true,
+							Description: "The status level to match (`CRIT`, `WARN`, `INFO`, `OK`, `UNKNOWN` or `ANY`).",
+							Validators: []validator.String{
+								stringvalidator.OneOf(notificationRuleStatusLevels...),
+							},
+						},
+						"previous_level": schema.StringAttribute{
+							Optional:    true,
+							Description: "The status level the check must have changed from (`CRIT`, `WARN`, `INFO`, `OK`, `UNKNOWN` or `ANY`).",
+							Validators: []validator.String{
+								stringvalidator.OneOf(notificationRuleStatusLevels...),
+							},
+						},
+					},
+				},
+			},
+			"tag_rules": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The tags a check status must carry to be notified on.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "The tag key.",
+						},
+						"operator": schema.StringAttribute{
+							Computed:    true,
+							Optional:    true,
+							Description: "The comparison operator (`equal`, `notequal`, `equalregex` or `notequalregex`). Defaults to `equal`.",
+							Default:     stringdefault.StaticString(string(domain.TagRuleOperatorEqual)),
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"equal", "notequal", "equalregex", "notequalregex"}...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "The tag value.",
+						},
+					},
+				},
+			},
+			"task_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the task that runs the notification rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the notification rule was last updated.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ModifyPlan checks the channel and message template against the type of the referenced endpoint,
+// as the rule type follows the endpoint and InfluxDB ignores the attributes the type does not use.
+func (r *NotificationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the rule is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var endpointID, orgID, channel, messageTemplate types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("endpoint_id"), &endpointID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("org_id"), &orgID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("channel"), &channel)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("message_template"), &messageTemplate)...)
+	if resp.Diagnostics.HasError() || endpointID.IsUnknown() || endpointID.IsNull() {
+		return
+	}
+
+	endpointType, err := r.endpointType(ctx, endpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading notification endpoint",
+			"Could not read notification endpoint "+endpointID.ValueString(),
+			err,
+			apierror.Permission(domain.PermissionActionRead, orgID.ValueString(), domain.ResourceTypeNotificationEndpoints),
+		))
+
+		return
+	}
+
+	if !channel.IsNull() && !channel.IsUnknown() && endpointType != string(domain.NotificationEndpointTypeSlack) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel"),
+			"Invalid notification rule channel",
+			fmt.Sprintf("The channel attribute can only be set with slack endpoints, the endpoint %s is of type %q.", endpointID.ValueString(), endpointType),
+		)
+	}
+	if !messageTemplate.IsNull() && !messageTemplate.IsUnknown() && endpointType == string(domain.NotificationEndpointTypeHttp) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("message_template"),
+			"Invalid notification rule message template",
+			fmt.Sprintf("The message_template attribute cannot be set with http endpoints, the endpoint %s is of type %q.", endpointID.ValueString(), endpointType),
+		)
+	}
+	if messageTemplate.IsNull() && endpointType != string(domain.NotificationEndpointTypeHttp) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("message_template"),
+			"Missing notification rule message template",
+			fmt.Sprintf("The message_template attribute must be set with %s endpoints.", endpointType),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *NotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationRuleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	endpointType, err := r.endpointType(ctx, plan.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading notification endpoint",
+			"Could not read notification endpoint "+plan.EndpointID.ValueString(),
+			err,
+			apierror.Permission(domain.PermissionActionRead, plan.OrgID.ValueString(), domain.ResourceTypeNotificationEndpoints),
+		))
+
+		return
+	}
+
+	// Generate API request body from plan
+	createRule := convertModelToNotificationRule(plan, endpointType)
+
+	var apiResponse notificationRuleJSON
+	err = doAPIRequest(ctx, r.client, nethttp.MethodPost, "notificationRules", createRule, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating notification rule",
+			"Could not create notification rule",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeNotificationRules),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := convertNotificationRuleToModel(apiResponse)
+	state.Timeouts = plan.Timeouts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state NotificationRuleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed notification rule value from InfluxDB
+	var rule notificationRuleJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodGet, "notificationRules/"+state.Id.ValueString(), nil, &rule)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading notification rule",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeNotificationRules),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	readTimeouts := state.Timeouts
+	state = convertNotificationRuleToModel(rule)
+	state.Timeouts = readTimeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *NotificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationRuleModel
+	var state NotificationRuleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpointType, err := r.endpointType(ctx, plan.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading notification endpoint",
+			"Could not read notification endpoint "+plan.EndpointID.ValueString(),
+			err,
+			apierror.Permission(domain.PermissionActionRead, plan.OrgID.ValueString(), domain.ResourceTypeNotificationEndpoints),
+		))
+
+		return
+	}
+
+	// Generate API request body from plan
+	updateRule := convertModelToNotificationRule(plan, endpointType)
+	updateRule.Id = state.Id.ValueStringPointer()
+
+	// Update existing notification rule
+	var apiResponse notificationRuleJSON
+	err = doAPIRequest(ctx, r.client, nethttp.MethodPut, "notificationRules/"+state.Id.ValueString(), updateRule, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating notification rule",
+			"Could not update notification rule",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeNotificationRules),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newState := convertNotificationRuleToModel(apiResponse)
+	newState.Timeouts = plan.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationRuleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing notification rule
+	err := r.client.APIClient().DeleteNotificationRulesID(ctx, &domain.DeleteNotificationRulesIDAllParams{
+		RuleID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting notification rule",
+			"Could not delete notification rule",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeNotificationRules),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_notification_rule")...)
+
+	r.client = client
+}
+
+func (r *NotificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// endpointType returns the type of the notification endpoint, which the rule type must match
+func (r *NotificationRuleResource) endpointType(ctx context.Context, endpointID string) (string, error) {
+	var endpoint notificationEndpointJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodGet, "notificationEndpoints/"+endpointID, nil, &endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	return string(endpoint.Type), nil
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNotificationRuleResourceConfig("test-rule", "CRIT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_notification_rule.test", "id"),
+					resource.TestCheckResourceAttrSet("influxdb_notification_rule.test", "task_id"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "name", "test-rule"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "status", "active"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "every", "1m"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "status_rules.#", "2"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "status_rules.0.current_level", "CRIT"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "status_rules.1.previous_level", "CRIT"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "tag_rules.0.operator", "equal"),
+					resource.TestCheckResourceAttrPair("influxdb_notification_rule.test", "endpoint_id", "influxdb_notification_endpoint.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_notification_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNotificationRuleResourceConfig("test-rule-updated", "WARN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "name", "test-rule-updated"),
+					resource.TestCheckResourceAttr("influxdb_notification_rule.test", "status_rules.0.current_level", "WARN"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationRuleResourceConfig(name string, level string) string {
+	return fmt.Sprintf(`
+resource "influxdb_notification_endpoint" "test" {
+  name   = "test-rule-endpoint"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  type   = "slack"
+  slack = {
+    url = "https://hooks.slack.com/services/x/y/z"
+  }
+}
+
+resource "influxdb_notification_rule" "test" {
+  name             = %[1]q
+  org_id           = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  endpoint_id      = influxdb_notification_endpoint.test.id
+  every            = "1m"
+  message_template = "$${ r._check_name } is $${ r._level }"
+  status_rules = [
+    {
+      current_level = %[2]q
+    },
+    {
+      current_level  = "OK"
+      previous_level = "CRIT"
+    },
+  ]
+  tag_rules = [
+    {
+      key   = "host"
+      value = "server01"
+    },
+  ]
+}
+`, name, level)
+}
+
+func TestAccNotificationRuleResourceEndpointType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the http endpoint first, so its type is known when the rule is planned
+			{
+				Config: providerConfig + testAccNotificationRuleResourceHTTPEndpointConfig(""),
+			},
+			// A message template cannot be set with an http endpoint
+			{
+				Config:      providerConfig + testAccNotificationRuleResourceHTTPEndpointConfig(`message_template = "$${ r._check_name }"`),
+				ExpectError: regexp.MustCompile(`Invalid notification rule message template`),
+			},
+			// A channel can only be set with a slack endpoint
+			{
+				Config:      providerConfig + testAccNotificationRuleResourceHTTPEndpointConfig(`channel = "#alerts"`),
+				ExpectError: regexp.MustCompile(`Invalid notification rule channel`),
+			},
+		},
+	})
+}
+
+func testAccNotificationRuleResourceHTTPEndpointConfig(ruleAttribute string) string {
+	config := `
+resource "influxdb_notification_endpoint" "test" {
+  name   = "test-rule-http-endpoint"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  type   = "http"
+  http = {
+    url = "https://example.com/alerts"
+  }
+}
+`
+	if ruleAttribute == "" {
+		return config
+	}
+
+	return config + `
+resource "influxdb_notification_rule" "test" {
+  name        = "test-rule-http"
+  org_id      = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  endpoint_id = influxdb_notification_endpoint.test.id
+  every       = "1m"
+  ` + ruleAttribute + `
+  status_rules = [
+    {
+      current_level = "CRIT"
+    },
+  ]
+}
+`
+}
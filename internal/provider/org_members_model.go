@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// OrgMembersModel maps the complete InfluxDB organization member and owner sets.
+type OrgMembersModel struct {
+	AllowSelfRemoval types.Bool     `tfsdk:"allow_self_removal"`
+	Id               types.String   `tfsdk:"id"`
+	MemberIDs        types.Set      `tfsdk:"member_ids"`
+	OrgID            types.String   `tfsdk:"org_id"`
+	OwnerIDs         types.Set      `tfsdk:"owner_ids"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// convertUsersToIDSet converts users to a set of user IDs
+func convertUsersToIDSet(ctx context.Context, users []domain.UserResponse) (types.Set, diag.Diagnostics) {
+	userIDs := []string{}
+	for _, user := range users {
+		if user.Id != nil {
+			userIDs = append(userIDs, *user.Id)
+		}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, userIDs)
+}
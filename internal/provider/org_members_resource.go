@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &OrgMembersResource{}
+	_ resource.ResourceWithImportState = &OrgMembersResource{}
+)
+
+// NewOrgMembersResource is a helper function to simplify the provider implementation.
+func NewOrgMembersResource() resource.Resource {
+	return &OrgMembersResource{}
+}
+
+// OrgMembersResource defines the resource implementation.
+type OrgMembersResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *OrgMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_members"
+}
+
+// Schema defines the schema for the resource.
+func (r *OrgMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the complete member and owner sets of an organization. Members and owners added outside of Terraform show up as drift and are removed on apply. " +
+			"Do not combine it with `influxdb_org_member`, `influxdb_org_owner` or the `org_id` and `org_role` attributes of `influxdb_user` for the same organization. " +
+			"Destroying the resource leaves the members and owners in place. Existing organizations are imported using the organization ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"allow_self_removal": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Allow removing the owner role of the user the provider is authenticated as. Defaults to `false`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"member_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of all users that are members of the organization.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of all users that are owners of the organization.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *OrgMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrgMembersModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Reconcile the members and owners InfluxDB currently has with the plan
+	err := r.reconcile(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error managing organization members",
+			"Could not manage organization members",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeOrgs),
+		))
+
+		return
+	}
+
+	plan.Id = plan.OrgID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *OrgMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state OrgMembersModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed organization members from InfluxDB
+	members, err := r.client.OrganizationsAPI().GetMembersWithID(ctx, state.OrgID.ValueString())
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error getting organization members",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeOrgs),
+		))
+
+		return
+	}
+
+	// Get refreshed organization owners from InfluxDB
+	owners, err := r.client.OrganizationsAPI().GetOwnersWithID(ctx, state.OrgID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error getting organization owners",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeOrgs),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	memberIDs, diags := convertUsersToIDSet(ctx, resourceMembersToUsers(members))
+	resp.Diagnostics.Append(diags...)
+	ownerIDs, diags := convertUsersToIDSet(ctx, resourceOwnersToUsers(owners))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = state.OrgID
+	state.MemberIDs = memberIDs
+	state.OwnerIDs = ownerIDs
+	if state.AllowSelfRemoval.IsNull() {
+		state.AllowSelfRemoval = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *OrgMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrgMembersModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Reconcile the members and owners InfluxDB currently has with the plan
+	err := r.reconcile(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error managing organization members",
+			"Could not manage organization members",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeOrgs),
+		))
+
+		return
+	}
+
+	plan.Id = plan.OrgID
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// The members and owners are left in place, removing them could lock everyone out of the organization.
+func (r *OrgMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *OrgMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_org_members")...)
+
+	r.client = client
+}
+
+func (r *OrgMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("org_id"), req, resp)
+}
+
+// reconcile adds and removes organization members and owners until InfluxDB matches the plan.
+// Users are added before any are removed, so a user moving between roles keeps access throughout.
+func (r *OrgMembersResource) reconcile(ctx context.Context, plan OrgMembersModel) error {
+	orgID := plan.OrgID.ValueString()
+
+	var desiredMembers, desiredOwners []string
+	if diags := plan.MemberIDs.ElementsAs(ctx, &desiredMembers, false); diags.HasError() {
+		return fmt.Errorf("failed to read member IDs")
+	}
+	if diags := plan.OwnerIDs.ElementsAs(ctx, &desiredOwners, false); diags.HasError() {
+		return fmt.Errorf("failed to read owner IDs")
+	}
+
+	members, err := r.client.OrganizationsAPI().GetMembersWithID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get members of organization %s: %w", orgID, err)
+	}
+	owners, err := r.client.OrganizationsAPI().GetOwnersWithID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get owners of organization %s: %w", orgID, err)
+	}
+
+	var currentMembers, currentOwners []string
+	for _, user := range resourceMembersToUsers(members) {
+		currentMembers = append(currentMembers, *user.Id)
+	}
+	for _, user := range resourceOwnersToUsers(owners) {
+		currentOwners = append(currentOwners, *user.Id)
+	}
+
+	membersToAdd, membersToRemove := diffIDs(currentMembers, desiredMembers)
+	ownersToAdd, ownersToRemove := diffIDs(currentOwners, desiredOwners)
+
+	// Refuse to lock the authenticated user out of the organization
+	if len(ownersToRemove) > 0 && !plan.AllowSelfRemoval.ValueBool() {
+		me, err := r.client.UsersAPI().Me(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get the authenticated user, set allow_self_removal to remove owners without this check: %w", err)
+		}
+		if me.Id != nil && slices.Contains(ownersToRemove, *me.Id) {
+			return fmt.Errorf("the plan removes the owner role of the authenticated user %s (%s) from organization %s. Add the user to owner_ids or set allow_self_removal to true", me.Name, *me.Id, orgID)
+		}
+	}
+
+	for _, userID := range ownersToAdd {
+		if _, err := r.client.OrganizationsAPI().AddOwnerWithID(ctx, orgID, userID); err != nil {
+			return fmt.Errorf("failed to add owner %s: %w", userID, err)
+		}
+	}
+	for _, userID := range membersToAdd {
+		if _, err := r.client.OrganizationsAPI().AddMemberWithID(ctx, orgID, userID); err != nil {
+			return fmt.Errorf("failed to add member %s: %w", userID, err)
+		}
+	}
+	for _, userID := range membersToRemove {
+		if err := r.client.OrganizationsAPI().RemoveMemberWithID(ctx, orgID, userID); err != nil && !apierror.IsNotFound(err) {
+			return fmt.Errorf("failed to remove member %s: %w", userID, err)
+		}
+	}
+	for _, userID := range ownersToRemove {
+		if err := r.client.OrganizationsAPI().RemoveOwnerWithID(ctx, orgID, userID); err != nil && !apierror.IsNotFound(err) {
+			return fmt.Errorf("failed to remove owner %s: %w", userID, err)
+		}
+	}
+
+	return nil
+}
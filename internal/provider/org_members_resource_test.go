@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgMembersResource(t *testing.T) {
+	orgName := acctest.RandomWithPrefix("tf-org-members-test")
+	password := acctest.RandomWithPrefix("password")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The authenticated user owns the new organization and must not be removed by default
+			{
+				Config:      providerConfig + testAccOrgMembersResourceConfig(orgName, password, "[influxdb_user.first.id]", "[influxdb_user.owner.id]", false),
+				ExpectError: regexp.MustCompile(`owner role of the authenticated user`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccOrgMembersResourceConfig(orgName, password, "[influxdb_user.first.id]", "[influxdb_user.owner.id]", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("influxdb_org_members.test", "id", "influxdb_organization.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_org_members.test", "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_org_members.test", "member_ids.*", "influxdb_user.first", "id"),
+					resource.TestCheckResourceAttr("influxdb_org_members.test", "owner_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_org_members.test", "owner_ids.*", "influxdb_user.owner", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "influxdb_org_members.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_self_removal"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccOrgMembersResourceConfig(orgName, password, "[influxdb_user.second.id]", "[influxdb_user.owner.id, influxdb_user.first.id]", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_org_members.test", "member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_org_members.test", "member_ids.*", "influxdb_user.second", "id"),
+					resource.TestCheckResourceAttr("influxdb_org_members.test", "owner_ids.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrgMembersResourceConfig(orgName string, password string, memberIDs string, ownerIDs string, allowSelfRemoval bool) string {
+	return fmt.Sprintf(`
+resource "influxdb_organization" "test" {
+  name = %[1]q
+}
+
+resource "influxdb_user" "owner" {
+  name     = "%[1]s-owner"
+  password = %[2]q
+}
+
+resource "influxdb_user" "first" {
+  name     = "%[1]s-first"
+  password = %[2]q
+}
+
+resource "influxdb_user" "second" {
+  name     = "%[1]s-second"
+  password = %[2]q
+}
+
+resource "influxdb_org_members" "test" {
+  org_id             = influxdb_organization.test.id
+  member_ids         = %[3]s
+  owner_ids          = %[4]s
+  allow_self_removal = %[5]t
+}
+`, orgName, password, memberIDs, ownerIDs, allowSelfRemoval)
+}
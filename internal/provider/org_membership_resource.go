@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &OrgMembershipResource{}
+	_ resource.ResourceWithImportState = &OrgMembershipResource{}
+)
+
+// NewOrgMemberResource is a helper function to simplify the provider implementation.
+func NewOrgMemberResource() resource.Resource {
+	return newOrgMembershipResource("member")
+}
+
+// NewOrgOwnerResource is a helper function to simplify the provider implementation.
+func NewOrgOwnerResource() resource.Resource {
+	return newOrgMembershipResource("owner")
+}
+
+// newOrgMembershipResource returns the organization membership resource of role.
+func newOrgMembershipResource(role string) *OrgMembershipResource {
+	return &OrgMembershipResource{
+		membershipResource: membershipResource{
+			role:               role,
+			target:             orgMembershipTarget{},
+			targetAttribute:    "org_id",
+			targetName:         "organization",
+			targetResourceType: domain.ResourceTypeOrgs,
+			typeName:           "org_" + role,
+		},
+	}
+}
+
+// OrgMembershipResource defines the resource implementation.
+// It manages a single member or owner edge between a user and an organization.
+type OrgMembershipResource struct {
+	membershipResource
+}
+
+// Metadata returns the resource type name.
+func (r *OrgMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *OrgMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("Adds a user as %s of an organization. The user does not need to be managed by Terraform. Do not combine it with the `org_id` and `org_role` attributes of `influxdb_user` for the same user and organization. Existing %ss are imported using `<org_id>/<user_id>`.", roleWithArticle(r.role), r.role),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID in the form `<org_id>/<user_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The ID of the user to add as %s.", r.role),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// orgMembershipTarget adds, lists and removes the members and owners of an organization.
+type orgMembershipTarget struct{}
+
+// addUser adds the user to the organization in the role
+func (orgMembershipTarget) addUser(ctx context.Context, client influxdb2.Client, role string, orgID string, userID string) (*domain.UserResponse, error) {
+	if role == "owner" {
+		owner, err := client.OrganizationsAPI().AddOwnerWithID(ctx, orgID, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &owner.UserResponse, nil
+	}
+
+	member, err := client.OrganizationsAPI().AddMemberWithID(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &member.UserResponse, nil
+}
+
+// listUsers returns the users of the organization in the role
+func (orgMembershipTarget) listUsers(ctx context.Context, client influxdb2.Client, role string, orgID string) ([]domain.UserResponse, error) {
+	if role == "owner" {
+		owners, err := client.OrganizationsAPI().GetOwnersWithID(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		return resourceOwnersToUsers(owners), nil
+	}
+
+	members, err := client.OrganizationsAPI().GetMembersWithID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return resourceMembersToUsers(members), nil
+}
+
+// removeUser removes the user from the organization in the role
+func (orgMembershipTarget) removeUser(ctx context.Context, client influxdb2.Client, role string, orgID string, userID string) error {
+	if role == "owner" {
+		return client.OrganizationsAPI().RemoveOwnerWithID(ctx, orgID, userID)
+	}
+	return client.OrganizationsAPI().RemoveMemberWithID(ctx, orgID, userID)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgMemberResource(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tf-org-member-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccOrgMembershipResourceConfig("member", userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_org_member.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttrPair("influxdb_org_member.test", "user_id", "influxdb_user.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_org_member.test", "user_name", userName),
+					resource.TestCheckResourceAttrSet("influxdb_org_member.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_org_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrgOwnerResource(t *testing.T) {
+	userName := acctest.RandomWithPrefix("tf-org-owner-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccOrgMembershipResourceConfig("owner", userName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_org_owner.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttrPair("influxdb_org_owner.test", "user_id", "influxdb_user.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_org_owner.test", "user_name", userName),
+					resource.TestCheckResourceAttrSet("influxdb_org_owner.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_org_owner.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrgMembershipResourceConfig(role string, userName string) string {
+	return fmt.Sprintf(`
+resource "influxdb_user" "test" {
+  name     = %[2]q
+  password = %[3]q
+}
+
+resource "influxdb_org_%[1]s" "test" {
+  org_id  = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  user_id = influxdb_user.test.id
+}
+`, role, userName, acctest.RandomWithPrefix("password"))
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -79,6 +82,8 @@ func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_organization")...)
+
 	d.client = client
 }
 
@@ -103,10 +108,12 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	organization, err := d.client.OrganizationsAPI().FindOrganizationByName(ctx, orgName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Organization not found",
-			err.Error(),
-		)
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading organization",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeOrgs),
+		))
 
 		return
 	}
@@ -1,6 +1,9 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // OrganizationModel maps InfluxDB organization schema data.
 type OrganizationModel struct {
@@ -10,3 +13,9 @@ type OrganizationModel struct {
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
 }
+
+// OrganizationResourceModel maps the organization resource schema data, adding the timeouts to OrganizationModel.
+type OrganizationResourceModel struct {
+	OrganizationModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -68,12 +71,16 @@ func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 				Description: "Last Organization update date.",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan OrganizationModel
+	var plan OrganizationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -81,6 +88,15 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	createOrganization := domain.Organization{
 		Id:          plan.Id.ValueStringPointer(),
@@ -90,10 +106,12 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 
 	apiResponse, err := r.client.OrganizationsAPI().CreateOrganization(ctx, &createOrganization)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error creating organization",
-			"Could not create organization, unexpected error: "+err.Error(),
-		)
+			"Could not create organization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeOrgs),
+		))
 
 		return
 	}
@@ -115,7 +133,7 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 // Read refreshes the Terraform state with the latest data.
 func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state OrganizationModel
+	var state OrganizationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -123,13 +141,29 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed organization value from InfluxDB
-	readOrganization, err := r.client.OrganizationsAPI().FindOrganizationByName(ctx, state.Name.ValueString())
+	readOrganization, err := r.client.OrganizationsAPI().FindOrganizationByID(ctx, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Organization not found",
-			err.Error(),
-		)
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading organization",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeOrgs),
+		))
 
 		return
 	}
@@ -150,7 +184,7 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan OrganizationModel
+	var plan OrganizationResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -158,6 +192,15 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	updateOrganization := domain.Organization{
 		Id:          plan.Id.ValueStringPointer(),
@@ -168,10 +211,12 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update existing organization
 	apiResponse, err := r.client.OrganizationsAPI().UpdateOrganization(ctx, &updateOrganization)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error updating organization",
-			"Could not update organization, unexpected error: "+err.Error(),
-		)
+			"Could not update organization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeOrgs),
+		))
 
 		return
 	}
@@ -192,7 +237,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state OrganizationModel
+	var state OrganizationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -200,13 +245,24 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing organization
 	err := r.client.OrganizationsAPI().DeleteOrganizationWithID(ctx, *state.Id.ValueStringPointer())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error deleting organization",
-			"Could not delete organization, unexpected error: "+err.Error(),
-		)
+			"Could not delete organization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeOrgs),
+		))
 
 		return
 	}
@@ -229,6 +285,8 @@ func (r *OrganizationResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_organization")...)
+
 	r.client = client
 }
 
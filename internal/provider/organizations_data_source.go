@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -91,6 +94,8 @@ func (d *OrganizationsDataSource) Configure(ctx context.Context, req datasource.
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_organizations")...)
+
 	d.client = client
 }
 
@@ -100,10 +105,12 @@ func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	organizations, err := d.client.OrganizationsAPI().GetOrganizations(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Unable to list Organizations",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeOrgs),
+		))
 
 		return
 	}
@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"math"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -27,10 +32,33 @@ type InfluxDBProvider struct {
 
 // InfluxDBProviderModel maps provider schema data to a Go type.
 type InfluxDBProviderModel struct {
-	URL      types.String `tfsdk:"url"`
-	Token    types.String `tfsdk:"token"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	URL                  types.String `tfsdk:"url"`
+	Token                types.String `tfsdk:"token"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	AllowUnauthenticated types.Bool   `tfsdk:"allow_unauthenticated"`
+	CACertFile           types.String `tfsdk:"ca_cert_file"`
+	CACertPEM            types.String `tfsdk:"ca_cert_pem"`
+	ClientCert           types.String `tfsdk:"client_cert"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	InsecureSkipVerify   types.Bool   `tfsdk:"insecure_skip_verify"`
+	MaxRetries           types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin         types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax         types.String `tfsdk:"retry_wait_max"`
+	RequestTimeout       types.String `tfsdk:"request_timeout"`
+	Org                  types.String `tfsdk:"org"`
+	OrgID                types.String `tfsdk:"org_id"`
+}
+
+// unauthenticatedClient is the client of a provider configured with allow_unauthenticated and no credentials.
+type unauthenticatedClient struct {
+	influxdb2.Client
+}
+
+// defaultOrgClient is the client of a provider configured with a default organization for resources.
+type defaultOrgClient struct {
+	influxdb2.Client
+	orgID string
 }
 
 // Metadata returns the provider type name.
@@ -63,6 +91,55 @@ func (p *InfluxDBProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"allow_unauthenticated": schema.BoolAttribute{
+				Description: "Allow configuring the provider without credentials, to check a new InfluxDB instance with the `influxdb_health` and `influxdb_ready` data sources and set it up with `influxdb_setup`. Resources and data sources that require authentication fail when no credentials are set. Defaults to `false`.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "The path of a PEM-encoded CA certificate file to trust in addition to the system certificates",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "A PEM-encoded CA certificate to trust in addition to the system certificates",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "A PEM-encoded client certificate for mutual TLS authentication. Requires `client_key`.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "The PEM-encoded private key of `client_cert`",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip the verification of the InfluxDB server certificate. Only use it for testing. Defaults to `false`.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The maximum number of retries of a request that InfluxDB rate limited or could not serve temporarily. `0` disables retries. Defaults to `3`.",
+				Optional:    true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: "The minimum wait before retrying a request, as a duration such as `500ms` or `1s`. The wait doubles with every retry. Defaults to `500ms`.",
+				Optional:    true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: "The maximum wait before retrying a request, as a duration such as `5s`. It also caps the wait requested by a `Retry-After` header. Defaults to `5s`.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "The timeout of a request to InfluxDB including its retries, as a duration such as `30s`. It is rounded up to whole seconds and should leave room for the waits of `max_retries` retries of up to `retry_wait_max` each. Defaults to `30s`.",
+				Optional:    true,
+			},
+			"org": schema.StringAttribute{
+				Description: "The name of the default organization of resources that omit `org_id`. It is resolved to an ID when the provider is configured. Conflicts with `org_id`.",
+				Optional:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The ID of the default organization of resources that omit `org_id`. Conflicts with `org`.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -116,6 +193,41 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.AllowUnauthenticated.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_unauthenticated"),
+			"Unknown InfluxDB Allow Unauthenticated",
+			"The provider cannot create the InfluxDB client as there is an unknown configuration value for allow_unauthenticated. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the INFLUXDB_ALLOW_UNAUTHENTICATED environment variable.",
+		)
+	}
+
+	for _, attribute := range []struct {
+		name    string
+		unknown bool
+	}{
+		{"ca_cert_file", config.CACertFile.IsUnknown()},
+		{"ca_cert_pem", config.CACertPEM.IsUnknown()},
+		{"client_cert", config.ClientCert.IsUnknown()},
+		{"client_key", config.ClientKey.IsUnknown()},
+		{"insecure_skip_verify", config.InsecureSkipVerify.IsUnknown()},
+		{"max_retries", config.MaxRetries.IsUnknown()},
+		{"retry_wait_min", config.RetryWaitMin.IsUnknown()},
+		{"retry_wait_max", config.RetryWaitMax.IsUnknown()},
+		{"request_timeout", config.RequestTimeout.IsUnknown()},
+		{"org", config.Org.IsUnknown()},
+		{"org_id", config.OrgID.IsUnknown()},
+	} {
+		if attribute.unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown InfluxDB Client Configuration",
+				"The provider cannot create the InfluxDB client as there is an unknown configuration value for "+attribute.name+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the INFLUXDB_"+strings.ToUpper(attribute.name)+" environment variable.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -127,6 +239,32 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 	token := os.Getenv("INFLUXDB_TOKEN")
 	username := os.Getenv("INFLUXDB_USERNAME")
 	password := os.Getenv("INFLUXDB_PASSWORD")
+	allowUnauthenticated := getEnvBool(path.Root("allow_unauthenticated"), "INFLUXDB_ALLOW_UNAUTHENTICATED", &resp.Diagnostics)
+	tlsSettings := providerTLSSettings{
+		CACertFile:         os.Getenv("INFLUXDB_CA_CERT_FILE"),
+		CACertPEM:          os.Getenv("INFLUXDB_CA_CERT_PEM"),
+		ClientCert:         os.Getenv("INFLUXDB_CLIENT_CERT"),
+		ClientKey:          os.Getenv("INFLUXDB_CLIENT_KEY"),
+		InsecureSkipVerify: getEnvBool(path.Root("insecure_skip_verify"), "INFLUXDB_INSECURE_SKIP_VERIFY", &resp.Diagnostics),
+	}
+	maxRetries := int64(defaultMaxRetries)
+	retryWaitMin := os.Getenv("INFLUXDB_RETRY_WAIT_MIN")
+	retryWaitMax := os.Getenv("INFLUXDB_RETRY_WAIT_MAX")
+	requestTimeout := os.Getenv("INFLUXDB_REQUEST_TIMEOUT")
+	org := os.Getenv("INFLUXDB_ORG")
+	orgID := os.Getenv("INFLUXDB_ORG_ID")
+
+	if v := os.Getenv("INFLUXDB_MAX_RETRIES"); v != "" {
+		var err error
+		maxRetries, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid InfluxDB Environment Variable",
+				"The INFLUXDB_MAX_RETRIES environment variable must be an integer value: "+err.Error(),
+			)
+		}
+	}
 
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
@@ -144,6 +282,53 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 		password = config.Password.ValueString()
 	}
 
+	if !config.AllowUnauthenticated.IsNull() {
+		allowUnauthenticated = config.AllowUnauthenticated.ValueBool()
+	}
+
+	if !config.CACertFile.IsNull() {
+		tlsSettings.CACertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.CACertPEM.IsNull() {
+		tlsSettings.CACertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.ClientCert.IsNull() {
+		tlsSettings.ClientCert = config.ClientCert.ValueString()
+	}
+
+	if !config.ClientKey.IsNull() {
+		tlsSettings.ClientKey = config.ClientKey.ValueString()
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		tlsSettings.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	if !config.RetryWaitMin.IsNull() {
+		retryWaitMin = config.RetryWaitMin.ValueString()
+	}
+
+	if !config.RetryWaitMax.IsNull() {
+		retryWaitMax = config.RetryWaitMax.ValueString()
+	}
+
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = config.RequestTimeout.ValueString()
+	}
+
+	// The default organization is set either by name or by ID, so a
+	// configuration value for either overrides both environment variables.
+	if !config.Org.IsNull() || !config.OrgID.IsNull() {
+		org = config.Org.ValueString()
+		orgID = config.OrgID.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -157,6 +342,39 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	tlsConfig, diags := buildTLSConfig(tlsSettings)
+	resp.Diagnostics.Append(diags...)
+
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid InfluxDB Max Retries",
+			"The maximum number of retries must not be negative. Set it to 0 to disable retries.",
+		)
+	}
+
+	retryWaitMinDuration := parseProviderDuration(path.Root("retry_wait_min"), retryWaitMin, defaultRetryWaitMin, &resp.Diagnostics)
+	retryWaitMaxDuration := parseProviderDuration(path.Root("retry_wait_max"), retryWaitMax, defaultRetryWaitMax, &resp.Diagnostics)
+
+	requestTimeoutDuration := parseProviderDuration(path.Root("request_timeout"), requestTimeout, defaultRequestTimeout, &resp.Diagnostics)
+
+	if org != "" && orgID != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Conflicting InfluxDB Organization",
+			"The default organization must be set either by name with org or by ID with org_id, not both. "+
+				"Remove one of them from the configuration or unset the INFLUXDB_ORG or INFLUXDB_ORG_ID environment variable.",
+		)
+	}
+
+	if retryWaitMinDuration > retryWaitMaxDuration {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid InfluxDB Retry Wait",
+			"The minimum retry wait "+retryWaitMinDuration.String()+" must not be greater than the maximum retry wait "+retryWaitMaxDuration.String()+".",
+		)
+	}
+
 	// Validate authentication credentials - require either token OR username+password
 	hasToken := token != ""
 	hasUsername := username != ""
@@ -164,14 +382,20 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 	hasCompleteUsernamePassword := hasUsername && hasPassword
 
 	if !hasToken && !hasUsername && !hasPassword {
-		// No authentication provided at all
-		resp.Diagnostics.AddError(
-			"Missing InfluxDB Authentication",
-			"The provider cannot create the InfluxDB client as the authentication credentials are missing or empty.\n\n"+
-				"Choose one of the following authentication methods:\n"+
-				"• Token authentication: Set 'token' in configuration or use INFLUXDB_TOKEN environment variable.\n"+
-				"• Password authentication: Set both 'username' and 'password' in configuration or use INFLUXDB_USERNAME & INFLUXDB_PASSWORD environment variable.",
-		)
+		if allowUnauthenticated {
+			// Resources and data sources check the authentication themselves
+			tflog.Warn(ctx, "Configuring InfluxDB client without authentication")
+		} else {
+			// No authentication provided at all
+			resp.Diagnostics.AddError(
+				"Missing InfluxDB Authentication",
+				"The provider cannot create the InfluxDB client as the authentication credentials are missing or empty.\n\n"+
+					"Choose one of the following authentication methods:\n"+
+					"• Token authentication: Set 'token' in configuration or use INFLUXDB_TOKEN environment variable.\n"+
+					"• Password authentication: Set both 'username' and 'password' in configuration or use INFLUXDB_USERNAME & INFLUXDB_PASSWORD environment variable.\n"+
+					"• No authentication: Set 'allow_unauthenticated' in configuration or use INFLUXDB_ALLOW_UNAUTHENTICATED environment variable to set up a new instance.",
+			)
+		}
 	} else if !hasToken && !hasCompleteUsernamePassword {
 		// Partial username/password credentials provided
 		if !hasUsername {
@@ -209,15 +433,28 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 	// Token authentication takes priority over username/password
 	var client influxdb2.Client
 
+	options := influxdb2.DefaultOptions().
+		SetHTTPRequestTimeout(uint(math.Ceil(requestTimeoutDuration.Seconds())))
+	if tlsConfig != nil {
+		options.SetTLSConfig(tlsConfig)
+	}
+
+	// Retry rate-limited and temporarily failed requests
+	httpClient := options.HTTPClient()
+	httpClient.Transport = newRetryTransport(httpClient.Transport, int(maxRetries), retryWaitMinDuration, retryWaitMaxDuration)
+
 	if token != "" {
 		// Use token authentication (priority)
-		client = influxdb2.NewClient(url, token)
+		client = influxdb2.NewClientWithOptions(url, token, options)
+	} else if !hasUsername && !hasPassword {
+		// No authentication, only allowed with allow_unauthenticated
+		client = &unauthenticatedClient{influxdb2.NewClientWithOptions(url, "", options)}
 	} else {
 		// Use username/password authentication (fallback)
 		client = influxdb2.NewClientWithOptions(
 			url,
 			"",
-			influxdb2.DefaultOptions(),
+			options,
 		)
 
 		err := client.UsersAPI().SignIn(context.Background(), username, password)
@@ -231,15 +468,40 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
-	_, err := client.Ping(context.Background())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create InfluxDB Client",
-			"An unexpected error occurred when creating the InfluxDB client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"InfluxDB Client Error: "+err.Error(),
-		)
-		return
+	// Without credentials the instance may still be starting, the health and ready data sources and
+	// influxdb_setup report a connection error themselves
+	if _, ok := client.(*unauthenticatedClient); !ok {
+		_, err := client.Ping(context.Background())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create InfluxDB Client",
+				"An unexpected error occurred when creating the InfluxDB client. "+
+					"If the error is not clear, please contact the provider developers.\n\n"+
+					"InfluxDB Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Resolve the default organization of resources once, the unauthenticated client cannot look it up
+	if _, ok := client.(*unauthenticatedClient); !ok {
+		if org != "" {
+			organization, err := client.OrganizationsAPI().FindOrganizationByName(ctx, org)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("org"),
+					"Unable to Resolve InfluxDB Organization",
+					"Failed to find the default organization "+strconv.Quote(org)+" in InfluxDB.\n\n"+
+						"InfluxDB Client Error: "+err.Error(),
+				)
+				return
+			}
+			orgID = *organization.Id
+		}
+
+		if orgID != "" {
+			client = &defaultOrgClient{Client: client, orgID: orgID}
+		}
 	}
 
 	// Make the InfluxDB client available during DataSource and Resource
@@ -250,15 +512,131 @@ func (p *InfluxDBProvider) Configure(ctx context.Context, req provider.Configure
 	tflog.Info(ctx, "Configured InfluxDB client", map[string]any{"success": true})
 }
 
+// getEnvBool returns the boolean value of the environment variable key, or false if it is not set.
+// An invalid value is reported as an error on the provider attribute it is the default of.
+func getEnvBool(attribute path.Path, key string, diags *diag.Diagnostics) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return false
+	}
+
+	value, err := strconv.ParseBool(v)
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid InfluxDB Environment Variable",
+			"The "+key+" environment variable must be a boolean value: "+err.Error(),
+		)
+	}
+
+	return value
+}
+
+// parseProviderDuration parses a duration attribute of the provider, returning defaultValue if value is empty.
+// An invalid or negative duration is reported as an error on attribute.
+func parseProviderDuration(attribute path.Path, value string, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid InfluxDB Duration",
+			"The value "+strconv.Quote(value)+" is not a valid non-negative duration, such as 500ms, 1s or 1m.",
+		)
+		return defaultValue
+	}
+
+	return duration
+}
+
+// requireAuthentication returns an error diagnostic if the provider was configured without credentials.
+// typeName is the type name of the resource or data source that requires authentication.
+func requireAuthentication(client influxdb2.Client, typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := client.(*unauthenticatedClient); ok {
+		diags.AddError(
+			"InfluxDB Authentication Required",
+			typeName+" requires authentication, but the provider is configured with allow_unauthenticated and no credentials. "+
+				"Set 'token' or 'username' and 'password' in the provider configuration, or use the INFLUXDB_TOKEN or INFLUXDB_USERNAME & INFLUXDB_PASSWORD environment variables.",
+		)
+	}
+
+	return diags
+}
+
+// defaultOrgID returns the ID of the default organization of the provider, or an empty string if it has none.
+func defaultOrgID(client influxdb2.Client) string {
+	if client, ok := client.(*defaultOrgClient); ok {
+		return client.orgID
+	}
+
+	return ""
+}
+
+// planDefaultOrgID sets the org_id attribute of a planned resource to the default organization
+// of the provider when the configuration omits it. It returns an error diagnostic when there is
+// no default organization either.
+func planDefaultOrgID(ctx context.Context, client influxdb2.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+
+	var configOrgID, planOrgID types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("org_id"), &configOrgID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("org_id"), &planOrgID)...)
+	if resp.Diagnostics.HasError() || !configOrgID.IsNull() || !planOrgID.IsUnknown() {
+		return
+	}
+
+	orgID := defaultOrgID(client)
+	if orgID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Missing Organization ID",
+			"The organization ID is not set and the provider has no default organization. "+
+				"Set org_id on the resource, or set org or org_id in the provider configuration or the INFLUXDB_ORG or INFLUXDB_ORG_ID environment variable.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("org_id"), orgID)...)
+}
+
 // Resources defines the resources implemented in the provider.
 func (p *InfluxDBProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAuthorizationResource,
+		NewBucketMemberResource,
+		NewBucketOwnerResource,
 		NewBucketResource,
+		NewCheckResource,
+		NewDashboardResource,
+		NewDBRPMappingResource,
+		NewLabelAssignmentResource,
 		NewLabelResource,
+		NewNotificationEndpointResource,
+		NewNotificationRuleResource,
+		NewOrgMemberResource,
+		NewOrgMembersResource,
+		NewOrgOwnerResource,
 		NewOrganizationResource,
+		NewRemoteConnectionResource,
+		NewReplicationResource,
+		NewScraperResource,
+		NewSecretResource,
+		NewSetupResource,
+		NewStackResource,
 		NewTaskResource,
+		NewTelegrafConfigResource,
 		NewUserResource,
+		NewV1AuthorizationResource,
+		NewVariableResource,
 	}
 }
 
@@ -269,14 +647,20 @@ func (p *InfluxDBProvider) DataSources(ctx context.Context) []func() datasource.
 		NewAuthorizationsDataSource,
 		NewBucketDataSource,
 		NewBucketsDataSource,
+		NewDBRPsDataSource,
+		NewHealthDataSource,
 		NewLabelDataSource,
 		NewLabelsDataSource,
 		NewOrganizationDataSource,
 		NewOrganizationsDataSource,
+		NewReadyDataSource,
+		NewSecretKeysDataSource,
 		NewTaskDataSource,
 		NewTasksDataSource,
+		NewTemplateExportDataSource,
 		NewUserDataSource,
 		NewUsersDataSource,
+		NewVariablesDataSource,
 	}
 }
 
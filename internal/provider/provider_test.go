@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 )
 
 const (
@@ -46,3 +47,9 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("INFLUXDB_ORG_ID must be set for acceptance tests")
 	}
 }
+
+// testAccClient returns an InfluxDB client for changing objects outside of Terraform
+// during acceptance testing.
+func testAccClient() influxdb2.Client {
+	return influxdb2.NewClient(os.Getenv("INFLUXDB_URL"), os.Getenv("INFLUXDB_TOKEN"))
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ReadyDataSource{}
+	_ datasource.DataSourceWithConfigure = &ReadyDataSource{}
+)
+
+// NewReadyDataSource is a helper function to simplify the provider implementation.
+func NewReadyDataSource() datasource.DataSource {
+	return &ReadyDataSource{}
+}
+
+// ReadyDataSource is the data source implementation.
+type ReadyDataSource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the data source type name.
+func (d *ReadyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ready"
+}
+
+// Schema defines the schema for the data source.
+func (d *ReadyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Retrieves the readiness of the InfluxDB instance. Does not require authentication, so it can be used with `allow_unauthenticated` before the instance is set up. Reading fails while the instance is not ready.",
+
+		Attributes: map[string]schema.Attribute{
+			"started": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the InfluxDB instance started.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The readiness status (`ready`).",
+			},
+			"up": schema.StringAttribute{
+				Computed:    true,
+				Description: "The uptime of the InfluxDB instance, e.g. `14m45.911966424s`.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+// The ready endpoint does not require authentication.
+func (d *ReadyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ready, err := d.client.Ready(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading readiness",
+			"Could not read the readiness of the InfluxDB instance",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	// Map response body to model
+	state := convertReadyToModel(ready)
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReadyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccReadyDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb_ready.test", "status", "ready"),
+					resource.TestCheckResourceAttrSet("data.influxdb_ready.test", "started"),
+					resource.TestCheckResourceAttrSet("data.influxdb_ready.test", "up"),
+				),
+			},
+			// The readiness does not require authentication
+			{
+				Config: testAccUnauthenticatedProviderConfig + testAccReadyDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb_ready.test", "status", "ready"),
+				),
+			},
+		},
+	})
+}
+
+const testAccReadyDataSourceConfig = `
+data "influxdb_ready" "test" {}
+`
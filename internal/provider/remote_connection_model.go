@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// RemoteConnectionModel maps InfluxDB remote connection schema data.
+type RemoteConnectionModel struct {
+	AllowInsecureTLS types.Bool     `tfsdk:"allow_insecure_tls"`
+	Description      types.String   `tfsdk:"description"`
+	Id               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	OrgID            types.String   `tfsdk:"org_id"`
+	RemoteAPIToken   types.String   `tfsdk:"remote_api_token"`
+	RemoteOrgID      types.String   `tfsdk:"remote_org_id"`
+	RemoteURL        types.String   `tfsdk:"remote_url"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// convertRemoteConnectionToModel converts a domain.RemoteConnection to RemoteConnectionModel.
+// The remote API token is never returned by InfluxDB, so it is taken from prior.
+func convertRemoteConnectionToModel(remote *domain.RemoteConnection, prior RemoteConnectionModel) RemoteConnectionModel {
+	return RemoteConnectionModel{
+		AllowInsecureTLS: types.BoolValue(remote.AllowInsecureTLS),
+		Description:      types.StringValue(stringOrEmpty(remote.Description)),
+		Id:               types.StringValue(remote.Id),
+		Name:             types.StringValue(remote.Name),
+		OrgID:            types.StringValue(remote.OrgID),
+		RemoteAPIToken:   prior.RemoteAPIToken,
+		RemoteOrgID:      types.StringValue(remote.RemoteOrgID),
+		RemoteURL:        types.StringValue(remote.RemoteURL),
+		Timeouts:         prior.Timeouts,
+	}
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &RemoteConnectionResource{}
+	_ resource.ResourceWithImportState = &RemoteConnectionResource{}
+)
+
+// NewRemoteConnectionResource is a helper function to simplify the provider implementation.
+func NewRemoteConnectionResource() resource.Resource {
+	return &RemoteConnectionResource{}
+}
+
+// RemoteConnectionResource defines the resource implementation.
+type RemoteConnectionResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *RemoteConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_connection"
+}
+
+// Schema defines the schema for the resource.
+func (r *RemoteConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a remote connection. Remote connections hold the credentials of a remote InfluxDB instance that buckets are replicated to with `influxdb_replication`.",
+
+		Attributes: map[string]schema.Attribute{
+			"allow_insecure_tls": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Skip TLS verification when connecting to the remote instance. Defaults to `false`.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the remote connection.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The remote connection ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the remote connection.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The local organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_api_token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The API token used to write to the remote instance. InfluxDB never returns the token, so changes made outside of Terraform are not detected.",
+			},
+			"remote_org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID on the remote instance.",
+			},
+			"remote_url": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the remote instance.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *RemoteConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RemoteConnectionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createRemote := domain.RemoteConnectionCreationRequest{
+		AllowInsecureTLS: plan.AllowInsecureTLS.ValueBool(),
+		Description:      plan.Description.ValueStringPointer(),
+		Name:             plan.Name.ValueString(),
+		OrgID:            plan.OrgID.ValueString(),
+		RemoteAPIToken:   plan.RemoteAPIToken.ValueString(),
+		RemoteOrgID:      plan.RemoteOrgID.ValueString(),
+		RemoteURL:        plan.RemoteURL.ValueString(),
+	}
+
+	apiResponse, err := r.client.APIClient().PostRemoteConnection(ctx, &domain.PostRemoteConnectionAllParams{
+		Body: domain.PostRemoteConnectionJSONRequestBody(createRemote),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating remote connection",
+			"Could not create remote connection",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeRemotes),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := convertRemoteConnectionToModel(apiResponse, plan)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *RemoteConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state RemoteConnectionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed remote connection value from InfluxDB
+	remote, err := r.client.APIClient().GetRemoteConnectionByID(ctx, &domain.GetRemoteConnectionByIDAllParams{
+		RemoteID: state.Id.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading remote connection",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeRemotes),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state = convertRemoteConnectionToModel(remote, state)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *RemoteConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RemoteConnectionModel
+	var state RemoteConnectionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateRemote := domain.RemoteConnectionUpdateRequest{
+		AllowInsecureTLS: plan.AllowInsecureTLS.ValueBoolPointer(),
+		Description:      plan.Description.ValueStringPointer(),
+		Name:             plan.Name.ValueStringPointer(),
+		RemoteOrgID:      plan.RemoteOrgID.ValueStringPointer(),
+		RemoteURL:        plan.RemoteURL.ValueStringPointer(),
+	}
+	if !plan.RemoteAPIToken.Equal(state.RemoteAPIToken) {
+		updateRemote.RemoteAPIToken = plan.RemoteAPIToken.ValueStringPointer()
+	}
+
+	// Update existing remote connection
+	apiResponse, err := r.client.APIClient().PatchRemoteConnectionByID(ctx, &domain.PatchRemoteConnectionByIDAllParams{
+		RemoteID: state.Id.ValueString(),
+		Body:     domain.PatchRemoteConnectionByIDJSONRequestBody(updateRemote),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating remote connection",
+			"Could not update remote connection",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeRemotes),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state = convertRemoteConnectionToModel(apiResponse, plan)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *RemoteConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RemoteConnectionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing remote connection
+	err := r.client.APIClient().DeleteRemoteConnectionByID(ctx, &domain.DeleteRemoteConnectionByIDAllParams{
+		RemoteID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting remote connection",
+			"Could not delete remote connection",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeRemotes),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RemoteConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_remote_connection")...)
+
+	r.client = client
+}
+
+func (r *RemoteConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRemoteConnectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccRemoteConnectionResourceConfig("test-remote", "Remote connection", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_remote_connection.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "name", "test-remote"),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "remote_url", os.Getenv("INFLUXDB_URL")),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "remote_org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "description", "Remote connection"),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "allow_insecure_tls", "false"),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "influxdb_remote_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remote_api_token"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccRemoteConnectionResourceConfig("test-remote-updated", "", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "name", "test-remote-updated"),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "description", ""),
+					resource.TestCheckResourceAttr("influxdb_remote_connection.test", "allow_insecure_tls", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRemoteConnectionResourceConfig(name string, description string, allowInsecureTLS bool) string {
+	descriptionAttribute := ""
+	if description != "" {
+		descriptionAttribute = fmt.Sprintf("description        = %q", description)
+	}
+
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "remote" {
+  name   = "test-remote-connection-bucket"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_authorization" "remote" {
+  org_id      = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  description = "Remote connection acceptance test"
+  permissions = [
+    {
+      action = "write"
+      resource = {
+        id   = influxdb_bucket.remote.id
+        type = "buckets"
+      }
+    },
+  ]
+}
+
+resource "influxdb_remote_connection" "test" {
+  name               = %[1]q
+  org_id             = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  remote_url         = "`+os.Getenv("INFLUXDB_URL")+`"
+  remote_org_id      = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  remote_api_token   = influxdb_authorization.remote.token
+  allow_insecure_tls = %[2]t
+  %[3]s
+}
+`, name, allowInsecureTLS, descriptionAttribute)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// ReplicationModel maps InfluxDB replication schema data.
+type ReplicationModel struct {
+	CurrentQueueSizeBytes types.Int64    `tfsdk:"current_queue_size_bytes"`
+	Description           types.String   `tfsdk:"description"`
+	DropNonRetryableData  types.Bool     `tfsdk:"drop_non_retryable_data"`
+	Id                    types.String   `tfsdk:"id"`
+	LatestErrorMessage    types.String   `tfsdk:"latest_error_message"`
+	LatestResponseCode    types.Int64    `tfsdk:"latest_response_code"`
+	LocalBucketID         types.String   `tfsdk:"local_bucket_id"`
+	MaxAgeSeconds         types.Int64    `tfsdk:"max_age_seconds"`
+	MaxQueueSizeBytes     types.Int64    `tfsdk:"max_queue_size_bytes"`
+	Name                  types.String   `tfsdk:"name"`
+	OrgID                 types.String   `tfsdk:"org_id"`
+	RemoteBucketID        types.String   `tfsdk:"remote_bucket_id"`
+	RemoteID              types.String   `tfsdk:"remote_id"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// replicationJSON is the wire format of a replication. domain.Replication
+// does not include the maximum age of queued data.
+type replicationJSON struct {
+	domain.Replication
+	MaxAgeSeconds int64 `json:"maxAgeSeconds"`
+}
+
+// convertReplicationToModel converts a replication to ReplicationModel.
+func convertReplicationToModel(replication *replicationJSON) ReplicationModel {
+	model := ReplicationModel{
+		CurrentQueueSizeBytes: types.Int64Value(replication.CurrentQueueSizeBytes),
+		Description:           types.StringValue(stringOrEmpty(replication.Description)),
+		DropNonRetryableData:  types.BoolValue(replication.DropNonRetryableData != nil && *replication.DropNonRetryableData),
+		Id:                    types.StringValue(replication.Id),
+		LatestErrorMessage:    types.StringPointerValue(replication.LatestErrorMessage),
+		LatestResponseCode:    types.Int64Null(),
+		LocalBucketID:         types.StringValue(replication.LocalBucketID),
+		MaxAgeSeconds:         types.Int64Value(replication.MaxAgeSeconds),
+		MaxQueueSizeBytes:     types.Int64Value(replication.MaxQueueSizeBytes),
+		Name:                  types.StringValue(replication.Name),
+		OrgID:                 types.StringValue(replication.OrgID),
+		RemoteBucketID:        types.StringPointerValue(replication.RemoteBucketID),
+		RemoteID:              types.StringValue(replication.RemoteID),
+	}
+	if replication.LatestResponseCode != nil {
+		model.LatestResponseCode = types.Int64Value(int64(*replication.LatestResponseCode))
+	}
+
+	return model
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ReplicationResource{}
+	_ resource.ResourceWithImportState = &ReplicationResource{}
+)
+
+// NewReplicationResource is a helper function to simplify the provider implementation.
+func NewReplicationResource() resource.Resource {
+	return &ReplicationResource{}
+}
+
+// ReplicationResource defines the resource implementation.
+type ReplicationResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ReplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication"
+}
+
+// Schema defines the schema for the resource.
+func (r *ReplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a replication. Replications queue data written to a local bucket and replicate it to a bucket on the remote instance of an `influxdb_remote_connection`.",
+
+		Attributes: map[string]schema.Attribute{
+			"current_queue_size_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The current size of the replication queue in bytes.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the replication.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"drop_non_retryable_data": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Drop data from the queue when the remote instance responds with a non-retryable error. Defaults to `false`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The replication ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"latest_error_message": schema.StringAttribute{
+				Computed:    true,
+				Description: "The latest error message returned by the remote instance.",
+			},
+			"latest_response_code": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The latest HTTP response code returned by the remote instance.",
+			},
+			"local_bucket_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the local bucket to replicate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_age_seconds": schema.Int64Attribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     int64default.StaticInt64(604800),
+				Description: "The maximum age of queued data in seconds. `0` keeps data until it is replicated. Defaults to `604800` (7 days).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_queue_size_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     int64default.StaticInt64(67108860),
+				Description: "The maximum size of the replication queue in bytes. Defaults to `67108860`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(33554430),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the replication.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The local organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_bucket_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the bucket on the remote instance to replicate to.",
+			},
+			"remote_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the remote connection.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ReplicationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createReplication := domain.ReplicationCreationRequest{
+		Description:          plan.Description.ValueStringPointer(),
+		DropNonRetryableData: plan.DropNonRetryableData.ValueBoolPointer(),
+		LocalBucketID:        plan.LocalBucketID.ValueString(),
+		MaxAgeSeconds:        plan.MaxAgeSeconds.ValueInt64(),
+		MaxQueueSizeBytes:    plan.MaxQueueSizeBytes.ValueInt64(),
+		Name:                 plan.Name.ValueString(),
+		OrgID:                plan.OrgID.ValueString(),
+		RemoteBucketID:       plan.RemoteBucketID.ValueStringPointer(),
+		RemoteID:             plan.RemoteID.ValueString(),
+	}
+
+	var apiResponse replicationJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodPost, "replications", createReplication, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating replication",
+			"Could not create replication",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeReplications),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := convertReplicationToModel(&apiResponse)
+	state.Timeouts = plan.Timeouts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ReplicationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed replication value from InfluxDB
+	var replication replicationJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodGet, "replications/"+state.Id.ValueString(), nil, &replication)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading replication",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeReplications),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	readTimeouts := state.Timeouts
+	state = convertReplicationToModel(&replication)
+	state.Timeouts = readTimeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ReplicationModel
+	var state ReplicationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateReplication := domain.ReplicationUpdateRequest{
+		Description:          plan.Description.ValueStringPointer(),
+		DropNonRetryableData: plan.DropNonRetryableData.ValueBoolPointer(),
+		MaxAgeSeconds:        plan.MaxAgeSeconds.ValueInt64Pointer(),
+		MaxQueueSizeBytes:    plan.MaxQueueSizeBytes.ValueInt64Pointer(),
+		Name:                 plan.Name.ValueStringPointer(),
+		RemoteBucketID:       plan.RemoteBucketID.ValueStringPointer(),
+		RemoteID:             plan.RemoteID.ValueStringPointer(),
+	}
+
+	// Update existing replication
+	var apiResponse replicationJSON
+	err := doAPIRequest(ctx, r.client, nethttp.MethodPatch, "replications/"+state.Id.ValueString(), updateReplication, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating replication",
+			"Could not update replication",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeReplications),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state = convertReplicationToModel(&apiResponse)
+	state.Timeouts = plan.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ReplicationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing replication
+	err := r.client.APIClient().DeleteReplicationByID(ctx, &domain.DeleteReplicationByIDAllParams{
+		ReplicationID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting replication",
+			"Could not delete replication",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeReplications),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ReplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_replication")...)
+
+	r.client = client
+}
+
+func (r *ReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReplicationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccReplicationResourceConfig("test-replication", 67108860),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_replication.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_replication.test", "name", "test-replication"),
+					resource.TestCheckResourceAttr("influxdb_replication.test", "max_queue_size_bytes", "67108860"),
+					resource.TestCheckResourceAttr("influxdb_replication.test", "max_age_seconds", "604800"),
+					resource.TestCheckResourceAttr("influxdb_replication.test", "drop_non_retryable_data", "false"),
+					resource.TestCheckResourceAttrSet("influxdb_replication.test", "current_queue_size_bytes"),
+					resource.TestCheckResourceAttrPair("influxdb_replication.test", "local_bucket_id", "influxdb_bucket.local", "id"),
+					resource.TestCheckResourceAttrPair("influxdb_replication.test", "remote_bucket_id", "influxdb_bucket.remote", "id"),
+					resource.TestCheckResourceAttrPair("influxdb_replication.test", "remote_id", "influxdb_remote_connection.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "influxdb_replication.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"current_queue_size_bytes", "latest_error_message", "latest_response_code"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccReplicationResourceConfig("test-replication-updated", 134217728),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_replication.test", "name", "test-replication-updated"),
+					resource.TestCheckResourceAttr("influxdb_replication.test", "max_queue_size_bytes", "134217728"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccReplicationResourceConfig(name string, maxQueueSizeBytes int) string {
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "local" {
+  name   = "test-replication-local"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_bucket" "remote" {
+  name   = "test-replication-remote"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_authorization" "remote" {
+  org_id      = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  description = "Replication acceptance test"
+  permissions = [
+    {
+      action = "write"
+      resource = {
+        id   = influxdb_bucket.remote.id
+        type = "buckets"
+      }
+    },
+  ]
+}
+
+resource "influxdb_remote_connection" "test" {
+  name             = "test-replication-remote"
+  org_id           = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  remote_url       = "`+os.Getenv("INFLUXDB_URL")+`"
+  remote_org_id    = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  remote_api_token = influxdb_authorization.remote.token
+}
+
+resource "influxdb_replication" "test" {
+  name                 = %[1]q
+  org_id               = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  remote_id            = influxdb_remote_connection.test.id
+  local_bucket_id      = influxdb_bucket.local.id
+  remote_bucket_id     = influxdb_bucket.remote.id
+  max_queue_size_bytes = %[2]d
+}
+`, name, maxQueueSizeBytes)
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"io"
+	"math/rand"
+	nethttp "net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry settings of the provider. The waits of all retries add up to at most 15s,
+// so the retries fit into the default request timeout.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 500 * time.Millisecond
+	defaultRetryWaitMax = 5 * time.Second
+)
+
+// retryTransport is an http.RoundTripper that retries requests InfluxDB rejected
+// because of rate limiting or temporary unavailability.
+//
+// Rate-limited requests (429) were not processed and are retried for all methods.
+// Server errors (502, 503, 504) and connection errors are only retried for idempotent methods,
+// as the request may have been processed. The wait before a retry is taken from the Retry-After
+// header if InfluxDB sends one, and is an exponential backoff with jitter otherwise. It is capped
+// at waitMax, and the last response is returned when the wait would exceed the request deadline.
+type retryTransport struct {
+	base       nethttp.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+// newRetryTransport returns a retryTransport that sends requests with base.
+func newRetryTransport(base nethttp.RoundTripper, maxRetries int, waitMin time.Duration, waitMax time.Duration) *retryTransport {
+	if base == nil {
+		base = nethttp.DefaultTransport
+	}
+
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		waitMin:    waitMin,
+		waitMax:    waitMax,
+	}
+}
+
+// RoundTrip sends the request and retries it as long as it is retryable and retries are left.
+func (t *retryTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		// Give up when the request would time out before the next attempt
+		wait := t.backoff(attempt, resp)
+		if deadline, ok := req.Context().Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+
+		// The request body was consumed and has to be rewound for the next attempt
+		if req.Body != nil && req.Body != nethttp.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		// Release the connection of the discarded response
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether the request is retried after the given response or error.
+func (t *retryTransport) shouldRetry(req *nethttp.Request, resp *nethttp.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch resp.StatusCode {
+	case nethttp.StatusTooManyRequests:
+		return true
+	case nethttp.StatusBadGateway, nethttp.StatusServiceUnavailable, nethttp.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// backoff returns the wait before the next attempt. It honors the Retry-After header of the response
+// up to waitMax, or else doubles waitMin with every attempt up to waitMax and randomizes the upper half of the wait.
+func (t *retryTransport) backoff(attempt int, resp *nethttp.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.waitMax)
+		}
+	}
+
+	wait := t.waitMin
+	for i := 0; i < attempt && wait < t.waitMax; i++ {
+		wait *= 2
+	}
+	if wait > t.waitMax {
+		wait = t.waitMax
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := nethttp.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// isIdempotent reports whether requests with the method can be sent more than once without additional effect.
+func isIdempotent(method string) bool {
+	switch method {
+	case nethttp.MethodGet, nethttp.MethodHead, nethttp.MethodOptions, nethttp.MethodPut, nethttp.MethodDelete:
+		return true
+	}
+
+	return false
+}
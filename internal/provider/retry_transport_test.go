@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+)
+
+// testRetryServer returns a server that responds with status to the first failures requests,
+// and with 200 and the request body afterwards. The number of requests is counted in calls.
+func testRetryServer(t *testing.T, failures int32, status int, retryAfter string, calls *int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if atomic.AddInt32(calls, 1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestRetryTransport(t *testing.T) {
+	testCases := map[string]struct {
+		method         string
+		status         int
+		failures       int32
+		retryAfter     string
+		expectedStatus int
+		expectedCalls  int32
+	}{
+		"rate limited GET": {
+			method:         nethttp.MethodGet,
+			status:         nethttp.StatusTooManyRequests,
+			failures:       2,
+			retryAfter:     "0",
+			expectedStatus: nethttp.StatusOK,
+			expectedCalls:  3,
+		},
+		"rate limited POST": {
+			method:         nethttp.MethodPost,
+			status:         nethttp.StatusTooManyRequests,
+			failures:       1,
+			expectedStatus: nethttp.StatusOK,
+			expectedCalls:  2,
+		},
+		"unavailable DELETE": {
+			method:         nethttp.MethodDelete,
+			status:         nethttp.StatusServiceUnavailable,
+			failures:       1,
+			expectedStatus: nethttp.StatusOK,
+			expectedCalls:  2,
+		},
+		"unavailable POST": {
+			method:         nethttp.MethodPost,
+			status:         nethttp.StatusServiceUnavailable,
+			failures:       1,
+			expectedStatus: nethttp.StatusServiceUnavailable,
+			expectedCalls:  1,
+		},
+		"retries exhausted": {
+			method:         nethttp.MethodGet,
+			status:         nethttp.StatusTooManyRequests,
+			failures:       10,
+			expectedStatus: nethttp.StatusTooManyRequests,
+			expectedCalls:  4,
+		},
+		"not retryable": {
+			method:         nethttp.MethodGet,
+			status:         nethttp.StatusInternalServerError,
+			failures:       1,
+			expectedStatus: nethttp.StatusInternalServerError,
+			expectedCalls:  1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			server := testRetryServer(t, testCase.failures, testCase.status, testCase.retryAfter, &calls)
+			client := &nethttp.Client{
+				Transport: newRetryTransport(nil, 3, time.Millisecond, 10*time.Millisecond),
+			}
+
+			req, err := nethttp.NewRequest(testCase.method, server.URL, strings.NewReader(`{"name":"test"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != testCase.expectedStatus {
+				t.Errorf("expected status %d, got: %d", testCase.expectedStatus, resp.StatusCode)
+			}
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d calls, got: %d", testCase.expectedCalls, calls)
+			}
+			if resp.StatusCode == nethttp.StatusOK && string(body) != `{"name":"test"}` {
+				t.Errorf("expected the request body to be sent again, got: %q", body)
+			}
+		})
+	}
+}
+
+func TestRetryTransportHonorsRetryAfter(t *testing.T) {
+	var calls int32
+	server := testRetryServer(t, 1, nethttp.StatusTooManyRequests, "1", &calls)
+	client := &nethttp.Client{
+		Transport: newRetryTransport(nil, 3, time.Millisecond, 2*time.Second),
+	}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected to wait for the Retry-After of 1s, waited: %s", elapsed)
+	}
+}
+
+func TestRetryTransportCapsRetryAfter(t *testing.T) {
+	var calls int32
+	server := testRetryServer(t, 1, nethttp.StatusTooManyRequests, "60", &calls)
+	client := &nethttp.Client{
+		Transport: newRetryTransport(nil, 3, time.Millisecond, 10*time.Millisecond),
+	}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the Retry-After of 60s to be capped at 10ms, waited: %s", elapsed)
+	}
+	if resp.StatusCode != nethttp.StatusOK {
+		t.Errorf("expected status %d, got: %d", nethttp.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestRetryTransportDeadline(t *testing.T) {
+	var calls int32
+	server := testRetryServer(t, 10, nethttp.StatusTooManyRequests, "1", &calls)
+	client := &nethttp.Client{
+		Transport: newRetryTransport(nil, 3, time.Millisecond, 2*time.Second),
+		Timeout:   200 * time.Millisecond,
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the last response instead of waiting past the deadline, got: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != nethttp.StatusTooManyRequests {
+		t.Errorf("expected status %d, got: %d", nethttp.StatusTooManyRequests, resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got: %d", calls)
+	}
+}
+
+func TestRetryTransportContextCanceled(t *testing.T) {
+	var calls int32
+	server := testRetryServer(t, 10, nethttp.StatusTooManyRequests, "60", &calls)
+	client := &nethttp.Client{
+		Transport: newRetryTransport(nil, 3, time.Millisecond, time.Minute),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	defer cancel()
+
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Do(req)
+	if err == nil {
+		t.Fatal("expected an error after the context was canceled")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got: %d", calls)
+	}
+}
+
+func TestRetryTransportInfluxDBClient(t *testing.T) {
+	var calls int32
+	server := testRetryServer(t, 2, nethttp.StatusTooManyRequests, "", &calls)
+
+	options := influxdb2.DefaultOptions()
+	httpClient := options.HTTPClient()
+	httpClient.Transport = newRetryTransport(httpClient.Transport, 3, time.Millisecond, 10*time.Millisecond)
+	client := influxdb2.NewClientWithOptions(server.URL, "token", options)
+	defer client.Close()
+
+	var result map[string]string
+	err := doAPIRequest(context.Background(), client, nethttp.MethodPost, "templates/export", map[string]string{"orgID": "0123456789abcdef"}, &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result["orgID"] != "0123456789abcdef" {
+		t.Errorf("unexpected response: %v", result)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got: %d", calls)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		"empty":    {value: "", ok: false},
+		"seconds":  {value: "5", expected: 5 * time.Second, ok: true},
+		"negative": {value: "-1", ok: false},
+		"past":     {value: "Mon, 02 Jan 2006 15:04:05 GMT", expected: 0, ok: true},
+		"invalid":  {value: "soon", ok: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			wait, ok := parseRetryAfter(testCase.value)
+			if ok != testCase.ok || wait != testCase.expected {
+				t.Errorf("expected %s, %t, got: %s, %t", testCase.expected, testCase.ok, wait, ok)
+			}
+		})
+	}
+}
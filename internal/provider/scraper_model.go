@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// ScraperModel maps InfluxDB scraper target schema data.
+type ScraperModel struct {
+	AllowInsecure types.Bool     `tfsdk:"allow_insecure"`
+	BucketID      types.String   `tfsdk:"bucket_id"`
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	OrgID         types.String   `tfsdk:"org_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Type          types.String   `tfsdk:"type"`
+	URL           types.String   `tfsdk:"url"`
+}
+
+// convertModelToScraperTargetRequest converts a ScraperModel to a domain.ScraperTargetRequest.
+func convertModelToScraperTargetRequest(plan ScraperModel) domain.ScraperTargetRequest {
+	scraperType := domain.ScraperTargetRequestType(plan.Type.ValueString())
+
+	return domain.ScraperTargetRequest{
+		AllowInsecure: plan.AllowInsecure.ValueBoolPointer(),
+		BucketID:      plan.BucketID.ValueStringPointer(),
+		Name:          plan.Name.ValueStringPointer(),
+		OrgID:         plan.OrgID.ValueStringPointer(),
+		Type:          &scraperType,
+		Url:           plan.URL.ValueStringPointer(),
+	}
+}
+
+// convertScraperTargetResponseToModel converts a domain.ScraperTargetResponse to ScraperModel.
+func convertScraperTargetResponseToModel(scraper *domain.ScraperTargetResponse) ScraperModel {
+	model := ScraperModel{
+		AllowInsecure: types.BoolValue(scraper.AllowInsecure != nil && *scraper.AllowInsecure),
+		BucketID:      types.StringPointerValue(scraper.BucketID),
+		Id:            types.StringPointerValue(scraper.Id),
+		Name:          types.StringPointerValue(scraper.Name),
+		OrgID:         types.StringPointerValue(scraper.OrgID),
+		Type:          types.StringNull(),
+		URL:           types.StringPointerValue(scraper.Url),
+	}
+	if scraper.Type != nil {
+		model.Type = types.StringValue(string(*scraper.Type))
+	}
+
+	return model
+}
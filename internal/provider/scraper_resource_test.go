@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScraperResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccScraperResourceConfig("test-scraper", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_scraper.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_scraper.test", "name", "test-scraper"),
+					resource.TestCheckResourceAttr("influxdb_scraper.test", "type", "prometheus"),
+					resource.TestCheckResourceAttr("influxdb_scraper.test", "url", "http://localhost:8086/metrics"),
+					resource.TestCheckResourceAttr("influxdb_scraper.test", "allow_insecure", "false"),
+					resource.TestCheckResourceAttr("influxdb_scraper.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttrPair("influxdb_scraper.test", "bucket_id", "influxdb_bucket.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_scraper.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccScraperResourceConfig("test-scraper-updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_scraper.test", "name", "test-scraper-updated"),
+					resource.TestCheckResourceAttr("influxdb_scraper.test", "allow_insecure", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccScraperResourceConfig(name string, allowInsecure bool) string {
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "test" {
+  name   = "test-scraper-bucket"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_scraper" "test" {
+  name           = %[1]q
+  url            = "http://localhost:8086/metrics"
+  bucket_id      = influxdb_bucket.test.id
+  org_id         = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  allow_insecure = %[2]t
+}
+`, name, allowInsecure)
+}
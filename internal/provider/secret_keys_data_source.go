@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SecretKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &SecretKeysDataSource{}
+)
+
+// NewSecretKeysDataSource is a helper function to simplify the provider implementation.
+func NewSecretKeysDataSource() datasource.DataSource {
+	return &SecretKeysDataSource{}
+}
+
+// SecretKeysDataSource is the data source implementation.
+type SecretKeysDataSource struct {
+	client influxdb2.Client
+}
+
+// SecretKeysDataSourceModel describes the data source data model.
+type SecretKeysDataSourceModel struct {
+	Keys  types.List   `tfsdk:"keys"`
+	OrgID types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecretKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_keys"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "List the secret keys of an organization. Secret values are never returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The secret keys.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SecretKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_secret_keys")...)
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *SecretKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SecretKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, err := d.client.APIClient().GetOrgsIDSecrets(ctx, &domain.GetOrgsIDSecretsAllParams{
+		OrgID: state.OrgID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Unable to list secret keys",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeSecrets),
+		))
+
+		return
+	}
+
+	// Map response body to model
+	keys := []string{}
+	if secrets.Secrets != nil {
+		keys = *secrets.Secrets
+	}
+
+	keyList, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Keys = keyList
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
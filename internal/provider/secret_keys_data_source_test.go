@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecretKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccSecretKeysDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.influxdb_secret_keys.all", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckTypeSetElemAttr("data.influxdb_secret_keys.all", "keys.*", "TEST_SECRET_KEYS"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSecretKeysDataSourceConfig = `
+resource "influxdb_secret" "test" {
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  key    = "TEST_SECRET_KEYS"
+  value  = "secret"
+}
+
+data "influxdb_secret_keys" "all" {
+  org_id = influxdb_secret.test.org_id
+}
+`
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SecretModel maps InfluxDB secret schema data.
+type SecretModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Key          types.String   `tfsdk:"key"`
+	OrgID        types.String   `tfsdk:"org_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+	Value        types.String   `tfsdk:"value"`
+	ValueVersion types.Int64    `tfsdk:"value_version"`
+}
+
+// secretID returns the resource ID of a secret, <org_id>/<key>.
+func secretID(orgID string, key string) string {
+	return orgID + "/" + key
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecretResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccSecretResourceConfig("initial", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_secret.test", "id", os.Getenv("INFLUXDB_ORG_ID")+"/TEST_SECRET"),
+					resource.TestCheckResourceAttr("influxdb_secret.test", "key", "TEST_SECRET"),
+					resource.TestCheckResourceAttr("influxdb_secret.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckNoResourceAttr("influxdb_secret.test", "value"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "influxdb_secret.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value_version"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccSecretResourceConfig("rotated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_secret.test", "value_version", "2"),
+					resource.TestCheckNoResourceAttr("influxdb_secret.test", "value"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSecretResourceConfig(value string, valueVersion int) string {
+	return fmt.Sprintf(`
+resource "influxdb_secret" "test" {
+  org_id        = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  key           = "TEST_SECRET"
+  value         = %[1]q
+  value_version = %[2]d
+}
+`, value, valueVersion)
+}
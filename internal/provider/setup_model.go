@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SetupModel maps InfluxDB onboarding schema data.
+type SetupModel struct {
+	Bucket               types.String   `tfsdk:"bucket"`
+	BucketID             types.String   `tfsdk:"bucket_id"`
+	Id                   types.String   `tfsdk:"id"`
+	Org                  types.String   `tfsdk:"org"`
+	OrgID                types.String   `tfsdk:"org_id"`
+	Password             types.String   `tfsdk:"password"`
+	RetentionPeriodHours types.Int64    `tfsdk:"retention_period_hours"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	Token                types.String   `tfsdk:"token"`
+	UserID               types.String   `tfsdk:"user_id"`
+	Username             types.String   `tfsdk:"username"`
+}
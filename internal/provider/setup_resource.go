@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SetupResource{}
+
+// NewSetupResource is a helper function to simplify the provider implementation.
+func NewSetupResource() resource.Resource {
+	return &SetupResource{}
+}
+
+// SetupResource defines the resource implementation.
+type SetupResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *SetupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setup"
+}
+
+// Schema defines the schema for the resource.
+func (r *SetupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Performs the initial setup of a new InfluxDB OSS instance, creating the initial user, organization, bucket and operator token. " +
+			"The setup can only be performed once per instance; creating the resource fails if the instance is already set up. " +
+			"InfluxDB cannot undo the setup, so destroying the resource only removes it from the Terraform state. " +
+			"The setup does not require authentication: configure the provider with `allow_unauthenticated`, or with the `token` that is pre-set as the operator token.",
+
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the initial bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bucket_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the initial bucket.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the initial organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the initial organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the initial organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The password of the initial user. The password is never stored in the Terraform state.",
+			},
+			"retention_period_hours": schema.Int64Attribute{
+				Computed:    true,
+				Optional:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The duration in hours for how long data will be kept in the initial bucket. The default `0` represents infinite retention.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The operator token. If not set, InfluxDB generates one.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the initial user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the initial user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SetupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SetupModel
+	var password types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check whether the instance still allows the setup
+	onboarding, err := r.client.APIClient().GetSetup(ctx, &domain.GetSetupParams{})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error checking InfluxDB setup",
+			"Could not check whether InfluxDB is set up",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	if onboarding.Allowed == nil || !*onboarding.Allowed {
+		resp.Diagnostics.AddError(
+			"InfluxDB already set up",
+			"The InfluxDB instance has already been set up and cannot be set up again. "+
+				"Remove the influxdb_setup resource from the configuration, or manage the existing user, organization and bucket with their resources instead.",
+		)
+
+		return
+	}
+
+	// Set up the instance
+	onboardingResponse, err := r.client.SetupWithToken(
+		ctx,
+		plan.Username.ValueString(),
+		password.ValueString(),
+		plan.Org.ValueString(),
+		plan.Bucket.ValueString(),
+		int(plan.RetentionPeriodHours.ValueInt64()),
+		plan.Token.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error setting up InfluxDB",
+			"Could not set up InfluxDB",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	if onboardingResponse.Auth != nil {
+		plan.Token = types.StringPointerValue(onboardingResponse.Auth.Token)
+	}
+	if onboardingResponse.Bucket != nil {
+		plan.BucketID = types.StringPointerValue(onboardingResponse.Bucket.Id)
+	}
+	if onboardingResponse.Org != nil {
+		plan.Id = types.StringPointerValue(onboardingResponse.Org.Id)
+		plan.OrgID = types.StringPointerValue(onboardingResponse.Org.Id)
+	}
+	if onboardingResponse.User != nil {
+		plan.UserID = types.StringPointerValue(onboardingResponse.User.Id)
+	}
+	plan.Password = types.StringNull()
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+// The setup is a one-time operation without a readable counterpart, so the state is kept as is.
+func (r *SetupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All attributes require replacement, so the plan is saved as is.
+func (r *SetupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SetupModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Password = types.StringNull()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+// InfluxDB cannot undo the setup, so the resource is only removed from the Terraform state.
+func (r *SetupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SetupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
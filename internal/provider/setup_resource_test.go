@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSetupResource(t *testing.T) {
+	password := acctest.RandomWithPrefix("password")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The acceptance test instance is already set up
+			{
+				Config:      providerConfig + testAccSetupResourceConfig(password),
+				ExpectError: regexp.MustCompile(`already been set up`),
+			},
+		},
+	})
+}
+
+func TestAccSetupResourceUnauthenticated(t *testing.T) {
+	password := acctest.RandomWithPrefix("password")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The setup does not require authentication
+			{
+				Config:      testAccUnauthenticatedProviderConfig + testAccSetupResourceConfig(password),
+				ExpectError: regexp.MustCompile(`already been set up`),
+			},
+			// Other resources fail without authentication
+			{
+				Config: testAccUnauthenticatedProviderConfig + `
+resource "influxdb_bucket" "test" {
+  name   = "test-unauthenticated-bucket"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+}
+`,
+				ExpectError: regexp.MustCompile(`influxdb_bucket requires authentication`),
+			},
+		},
+	})
+}
+
+// testAccUnauthenticatedProviderConfig configures the provider without the credentials of the environment.
+const testAccUnauthenticatedProviderConfig = `
+provider "influxdb" {
+  allow_unauthenticated = true
+  token                 = ""
+  username              = ""
+  password              = ""
+}
+`
+
+func testAccSetupResourceConfig(password string) string {
+	return `
+resource "influxdb_setup" "test" {
+  username = "test-setup-user"
+  password = "` + password + `"
+  org      = "test-setup-org"
+  bucket   = "test-setup-bucket"
+}
+`
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// StackModel maps InfluxDB stack schema data.
+type StackModel struct {
+	Description     types.String   `tfsdk:"description"`
+	EnvRefs         types.Map      `tfsdk:"env_refs"`
+	Id              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	OrgID           types.String   `tfsdk:"org_id"`
+	Resources       types.List     `tfsdk:"resources"`
+	Secrets         types.Map      `tfsdk:"secrets"`
+	TemplateChanges types.List     `tfsdk:"template_changes"`
+	TemplateURLs    types.List     `tfsdk:"template_urls"`
+	Templates       types.List     `tfsdk:"templates"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// StackResourceModel maps a resource installed by a stack.
+type StackResourceModel struct {
+	Kind             types.String `tfsdk:"kind"`
+	ResourceID       types.String `tfsdk:"resource_id"`
+	TemplateMetaName types.String `tfsdk:"template_meta_name"`
+}
+
+// stackResourceAttrTypes are the attribute types of StackResourceModel.
+var stackResourceAttrTypes = map[string]attr.Type{
+	"kind":               types.StringType,
+	"resource_id":        types.StringType,
+	"template_meta_name": types.StringType,
+}
+
+// templateApplyJSON is the request body of /api/v2/templates/apply.
+// domain.TemplateApply types the env references and actions as interface{},
+// so the request is sent with this flattened struct instead.
+type templateApplyJSON struct {
+	DryRun    bool                        `json:"dryRun"`
+	EnvRefs   map[string]string           `json:"envRefs,omitempty"`
+	OrgID     string                      `json:"orgID"`
+	Remotes   []templateApplyRemoteJSON   `json:"remotes,omitempty"`
+	Secrets   map[string]string           `json:"secrets,omitempty"`
+	StackID   string                      `json:"stackID,omitempty"`
+	Templates []templateApplyTemplateJSON `json:"templates,omitempty"`
+}
+
+// templateApplyRemoteJSON is a template fetched by InfluxDB from a URL.
+type templateApplyRemoteJSON struct {
+	URL string `json:"url"`
+}
+
+// templateApplyTemplateJSON is a template sent inline with the request.
+type templateApplyTemplateJSON struct {
+	ContentType string           `json:"contentType"`
+	Contents    []map[string]any `json:"contents"`
+}
+
+// templateSummaryJSON is the response body of /api/v2/templates/apply, reduced to the
+// fields the provider uses. The diff entries of all resource kinds share the fields below.
+type templateSummaryJSON struct {
+	Diff    map[string][]templateDiffJSON `json:"diff"`
+	Errors  []templateErrorJSON           `json:"errors"`
+	StackID string                        `json:"stackID"`
+}
+
+// templateDiffJSON is a single resource of a template diff.
+type templateDiffJSON struct {
+	Kind             string          `json:"kind"`
+	New              json.RawMessage `json:"new"`
+	Old              json.RawMessage `json:"old"`
+	StateStatus      string          `json:"stateStatus"`
+	TemplateMetaName string          `json:"templateMetaName"`
+
+	// Label mappings have no kind or new/old state, they reference a label and a resource instead.
+	LabelTemplateMetaName    string `json:"labelTemplateMetaName"`
+	ResourceTemplateMetaName string `json:"resourceTemplateMetaName"`
+	ResourceType             string `json:"resourceType"`
+	Status                   string `json:"status"`
+}
+
+// templateErrorJSON is a validation error of a template.
+type templateErrorJSON struct {
+	Fields []string `json:"fields"`
+	Kind   string   `json:"kind"`
+	Reason string   `json:"reason"`
+}
+
+// parseTemplateManifest parses a YAML or JSON template manifest into its template objects.
+// A manifest may hold several YAML documents, each being a single object or a list of objects.
+func parseTemplateManifest(manifest string) ([]map[string]any, error) {
+	var objects []map[string]any
+
+	decoder := yaml.NewDecoder(bytes.NewBufferString(manifest))
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch document := document.(type) {
+		case nil:
+			continue
+		case map[string]any:
+			objects = append(objects, document)
+		case []any:
+			for i, item := range document {
+				object, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("template object %d is not an object", i)
+				}
+				objects = append(objects, object)
+			}
+		default:
+			return nil, fmt.Errorf("template manifest must be an object or a list of objects")
+		}
+	}
+
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("template manifest is empty")
+	}
+
+	return objects, nil
+}
+
+// convertModelToTemplateApply converts a StackModel to a templates apply request.
+func convertModelToTemplateApply(ctx context.Context, plan StackModel, dryRun bool) (templateApplyJSON, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := templateApplyJSON{
+		DryRun:  dryRun,
+		OrgID:   plan.OrgID.ValueString(),
+		StackID: plan.Id.ValueString(),
+	}
+
+	if !plan.TemplateURLs.IsNull() {
+		var urls []string
+		diags.Append(plan.TemplateURLs.ElementsAs(ctx, &urls, false)...)
+		for _, url := range urls {
+			request.Remotes = append(request.Remotes, templateApplyRemoteJSON{URL: url})
+		}
+	}
+
+	if !plan.Templates.IsNull() {
+		var manifests []string
+		diags.Append(plan.Templates.ElementsAs(ctx, &manifests, false)...)
+		for i, manifest := range manifests {
+			objects, err := parseTemplateManifest(manifest)
+			if err != nil {
+				diags.AddError(
+					"Invalid template",
+					fmt.Sprintf("Could not parse template %d: %s", i, err.Error()),
+				)
+				continue
+			}
+			request.Templates = append(request.Templates, templateApplyTemplateJSON{
+				ContentType: "json",
+				Contents:    objects,
+			})
+		}
+	}
+
+	if !plan.EnvRefs.IsNull() {
+		diags.Append(plan.EnvRefs.ElementsAs(ctx, &request.EnvRefs, false)...)
+	}
+
+	if !plan.Secrets.IsNull() {
+		diags.Append(plan.Secrets.ElementsAs(ctx, &request.Secrets, false)...)
+	}
+
+	return request, diags
+}
+
+// templateChanges returns a readable line per resource a template apply creates, updates or deletes.
+func templateChanges(summary templateSummaryJSON) []string {
+	changes := []string{}
+
+	for _, entry := range summary.Diff["labelMappings"] {
+		action := templateChangeAction(entry.Status, false)
+		if action == "" {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s label mapping %q on %s %q", action, entry.LabelTemplateMetaName, entry.ResourceType, entry.ResourceTemplateMetaName))
+	}
+
+	for kind, entries := range summary.Diff {
+		if kind == "labelMappings" {
+			continue
+		}
+		for _, entry := range entries {
+			action := templateChangeAction(entry.StateStatus, !jsonEqual(entry.New, entry.Old))
+			if action == "" {
+				continue
+			}
+			changes = append(changes, fmt.Sprintf("%s %s %q", action, entry.Kind, entry.TemplateMetaName))
+		}
+	}
+
+	sort.Strings(changes)
+	return changes
+}
+
+// templateChangeAction maps the state status of a template diff entry to an action.
+// Existing resources are only reported when their state changes.
+func templateChangeAction(stateStatus string, changed bool) string {
+	switch stateStatus {
+	case "new":
+		return "create"
+	case "remove":
+		return "delete"
+	case "exists":
+		if changed {
+			return "update"
+		}
+	}
+	return ""
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal.
+func jsonEqual(a json.RawMessage, b json.RawMessage) bool {
+	var aValue, bValue any
+	if len(a) > 0 {
+		if err := json.Unmarshal(a, &aValue); err != nil {
+			return false
+		}
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &bValue); err != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+// templateErrorsToString formats the validation errors of a template apply.
+func templateErrorsToString(templateErrors []templateErrorJSON) string {
+	var buffer bytes.Buffer
+	for _, templateError := range templateErrors {
+		fmt.Fprintf(&buffer, "\n- %s %v: %s", templateError.Kind, templateError.Fields, templateError.Reason)
+	}
+	return buffer.String()
+}
+
+// convertStackResourcesToList converts the resources of the latest stack event to a list.
+func convertStackResourcesToList(ctx context.Context, stack *domain.Stack) (types.List, diag.Diagnostics) {
+	resources := []StackResourceModel{}
+
+	if stack.Events != nil && len(*stack.Events) > 0 {
+		latest := (*stack.Events)[len(*stack.Events)-1]
+		if latest.Resources != nil {
+			for _, resource := range *latest.Resources {
+				var kind *string
+				if resource.Kind != nil {
+					kind = (*string)(resource.Kind)
+				}
+				resources = append(resources, StackResourceModel{
+					Kind:             types.StringPointerValue(kind),
+					ResourceID:       types.StringPointerValue(resource.ResourceID),
+					TemplateMetaName: types.StringPointerValue(resource.TemplateMetaName),
+				})
+			}
+		}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: stackResourceAttrTypes}, resources)
+}
+
+// convertStackToModel populates a StackModel with the attributes InfluxDB reports for a stack.
+// The templates, environment references and secrets are not returned by InfluxDB and are left unchanged.
+func convertStackToModel(ctx context.Context, stack *domain.Stack, model *StackModel) diag.Diagnostics {
+	model.Id = types.StringPointerValue(stack.Id)
+	model.OrgID = types.StringPointerValue(stack.OrgID)
+
+	if stack.Events != nil && len(*stack.Events) > 0 {
+		latest := (*stack.Events)[len(*stack.Events)-1]
+		model.Description = types.StringValue(stringOrEmpty(latest.Description))
+		model.Name = types.StringValue(stringOrEmpty(latest.Name))
+	}
+
+	resources, diags := convertStackResourcesToList(ctx, stack)
+	model.Resources = resources
+
+	return diags
+}
+
+// stringOrEmpty returns the value of s, or an empty string if s is nil.
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
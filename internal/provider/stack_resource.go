@@ -0,0 +1,601 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &StackResource{}
+	_ resource.ResourceWithImportState    = &StackResource{}
+	_ resource.ResourceWithModifyPlan     = &StackResource{}
+	_ resource.ResourceWithValidateConfig = &StackResource{}
+)
+
+// NewStackResource is a helper function to simplify the provider implementation.
+func NewStackResource() resource.Resource {
+	return &StackResource{}
+}
+
+// StackResource defines the resource implementation.
+type StackResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *StackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack"
+}
+
+// Schema defines the schema for the resource.
+func (r *StackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Applies [InfluxDB templates](https://docs.influxdata.com/influxdb/v2/tools/influxdb-templates/) as a stack. " +
+			"The templates are dry-run during plan and the resources they create, update or delete are shown in `template_changes`. " +
+			"Destroying the stack uninstalls all resources it installed.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the stack.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"env_refs": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The values of the environment references (`envRef`) in the templates.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The stack ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The name of the stack.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The resources installed by the stack.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "The kind of the resource, e.g. `Bucket`.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the resource.",
+						},
+						"template_meta_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The `metadata.name` of the resource in the template.",
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secrets": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "The values of the secrets referenced by the templates.",
+			},
+			"template_changes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The resources the templates create, update or delete, as reported by the dry-run of the last plan.",
+			},
+			"template_urls": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The URLs of the templates to apply.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AtLeastOneOf(path.MatchRoot("templates")),
+				},
+			},
+			"templates": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The contents of the templates to apply, in YAML or JSON format, e.g. the output of `influx export`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AtLeastOneOf(path.MatchRoot("template_urls")),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates that the inline templates are valid YAML or JSON manifests.
+func (r *StackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var templates types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("templates"), &templates)...)
+	if resp.Diagnostics.HasError() || templates.IsNull() || templates.IsUnknown() {
+		return
+	}
+
+	for i, element := range templates.Elements() {
+		manifest, ok := element.(types.String)
+		if !ok || manifest.IsNull() || manifest.IsUnknown() {
+			continue
+		}
+
+		if _, err := parseTemplateManifest(manifest.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("templates").AtListIndex(i),
+				"Invalid template",
+				"The template is not a valid YAML or JSON manifest:\n\n"+err.Error(),
+			)
+		}
+	}
+}
+
+// ModifyPlan dry-runs the templates and shows the resources they change in template_changes.
+func (r *StackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to dry-run when the stack is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan StackModel
+	var state StackModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The templates can only be dry-run once all inputs are known
+	if !req.Config.Raw.IsFullyKnown() {
+		plan.TemplateChanges = types.ListUnknown(types.StringType)
+		plan.Resources = types.ListUnknown(types.ObjectType{AttrTypes: stackResourceAttrTypes})
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	dryRun, diags := convertModelToTemplateApply(ctx, plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	summary, err := r.applyTemplates(ctx, dryRun)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error planning stack",
+			"Could not dry-run the stack templates",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	changes := templateChanges(summary)
+
+	// Keep the prior changes when the stack is in sync, so an unchanged stack shows no diff
+	if len(changes) == 0 && !req.State.Raw.IsNull() {
+		plan.TemplateChanges = state.TemplateChanges
+	} else {
+		changeList, diags := types.ListValueFrom(ctx, types.StringType, changes)
+		resp.Diagnostics.Append(diags...)
+		plan.TemplateChanges = changeList
+		plan.Resources = types.ListUnknown(types.ObjectType{AttrTypes: stackResourceAttrTypes})
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StackModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	var urls []string
+	if !plan.TemplateURLs.IsNull() {
+		resp.Diagnostics.Append(plan.TemplateURLs.ElementsAs(ctx, &urls, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	stack, err := r.client.APIClient().CreateStack(ctx, &domain.CreateStackAllParams{
+		Body: domain.CreateStackJSONRequestBody{
+			Description: plan.Description.ValueStringPointer(),
+			Name:        plan.Name.ValueStringPointer(),
+			OrgID:       plan.OrgID.ValueStringPointer(),
+			Urls:        &urls,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating stack",
+			"Could not create stack",
+			err,
+			"",
+		))
+
+		return
+	}
+	plan.Id = types.StringPointerValue(stack.Id)
+
+	// Apply the templates to the new stack
+	changes, err := r.apply(ctx, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating stack",
+			"Could not apply stack templates",
+			err,
+			"",
+		))
+
+		// Remove the empty stack so a retry starts from scratch
+		_ = r.client.APIClient().DeleteStack(ctx, &domain.DeleteStackAllParams{
+			StackId:           plan.Id.ValueString(),
+			DeleteStackParams: domain.DeleteStackParams{OrgID: plan.OrgID.ValueString()},
+		})
+
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.TemplateChanges.IsUnknown() {
+		plan.TemplateChanges = changes
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state StackModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed stack value from InfluxDB
+	stack, err := r.client.APIClient().ReadStack(ctx, &domain.ReadStackAllParams{
+		StackId: state.Id.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading stack",
+			"",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(convertStackToModel(ctx, stack, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.TemplateChanges.IsNull() {
+		state.TemplateChanges = types.ListValueMust(types.StringType, nil)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan StackModel
+	var state StackModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	urls := []string{}
+	if !plan.TemplateURLs.IsNull() {
+		resp.Diagnostics.Append(plan.TemplateURLs.ElementsAs(ctx, &urls, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Update existing stack
+	_, err := r.client.APIClient().UpdateStack(ctx, &domain.UpdateStackAllParams{
+		StackId: state.Id.ValueString(),
+		Body: domain.UpdateStackJSONRequestBody{
+			Description:  plan.Description.ValueStringPointer(),
+			Name:         plan.Name.ValueStringPointer(),
+			TemplateURLs: &urls,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating stack",
+			"Could not update stack",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	// Apply the templates to the existing stack
+	plan.Id = state.Id
+	changes, err := r.apply(ctx, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating stack",
+			"Could not apply stack templates",
+			err,
+			"",
+		))
+
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.TemplateChanges.IsUnknown() {
+		plan.TemplateChanges = changes
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StackModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Uninstall the resources of the stack
+	_, err := r.client.APIClient().UninstallStack(ctx, &domain.UninstallStackAllParams{
+		StackId: state.Id.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error uninstalling stack",
+			"Could not uninstall stack",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	// Delete existing stack
+	err = r.client.APIClient().DeleteStack(ctx, &domain.DeleteStackAllParams{
+		StackId:           state.Id.ValueString(),
+		DeleteStackParams: domain.DeleteStackParams{OrgID: state.OrgID.ValueString()},
+	})
+	if err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting stack",
+			"Could not delete stack",
+			err,
+			"",
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_stack")...)
+
+	r.client = client
+}
+
+func (r *StackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyTemplates sends a templates apply request and returns its summary.
+func (r *StackResource) applyTemplates(ctx context.Context, request templateApplyJSON) (templateSummaryJSON, error) {
+	var summary templateSummaryJSON
+
+	err := doAPIRequest(ctx, r.client, nethttp.MethodPost, "templates/apply", request, &summary)
+	if err != nil {
+		return summary, err
+	}
+
+	if len(summary.Errors) > 0 {
+		return summary, fmt.Errorf("the templates are invalid:%s", templateErrorsToString(summary.Errors))
+	}
+
+	return summary, nil
+}
+
+// apply applies the templates of the plan to its stack and returns the changes it made.
+func (r *StackResource) apply(ctx context.Context, plan StackModel, diags *diag.Diagnostics) (types.List, error) {
+	request, requestDiags := convertModelToTemplateApply(ctx, plan, false)
+	diags.Append(requestDiags...)
+	if diags.HasError() {
+		return types.ListNull(types.StringType), nil
+	}
+
+	summary, err := r.applyTemplates(ctx, request)
+	if err != nil {
+		return types.ListNull(types.StringType), err
+	}
+
+	changes, changeDiags := types.ListValueFrom(ctx, types.StringType, templateChanges(summary))
+	diags.Append(changeDiags...)
+
+	return changes, nil
+}
+
+// refresh reads the stack of the plan and populates its computed attributes.
+func (r *StackResource) refresh(ctx context.Context, plan *StackModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stack, err := r.client.APIClient().ReadStack(ctx, &domain.ReadStackAllParams{
+		StackId: plan.Id.ValueString(),
+	})
+	if err != nil {
+		diags.Append(apierror.Diagnostic(
+			"Error reading stack",
+			"",
+			err,
+			"",
+		))
+
+		return diags
+	}
+
+	diags.Append(convertStackToModel(ctx, stack, plan)...)
+	return diags
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStackResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccStackResourceConfig("test stack", 2592000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_stack.test", "name", "test-stack"),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "description", "test stack"),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "template_changes.#", "1"),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "template_changes.0", `create Bucket "test-stack-bucket"`),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "resources.#", "1"),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "resources.0.kind", "Bucket"),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "resources.0.template_meta_name", "test-stack-bucket"),
+					resource.TestCheckResourceAttrSet("influxdb_stack.test", "resources.0.resource_id"),
+					resource.TestCheckResourceAttrSet("influxdb_stack.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "influxdb_stack.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"env_refs", "template_changes", "templates"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccStackResourceConfig("test stack updated", 604800),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_stack.test", "description", "test stack updated"),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "template_changes.#", "1"),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "template_changes.0", `update Bucket "test-stack-bucket"`),
+					resource.TestCheckResourceAttr("influxdb_stack.test", "resources.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccStackResourceInvalidTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "influxdb_stack" "test" {
+  org_id    = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  templates = ["apiVersion: [influxdata.com/v2alpha1"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid template`),
+			},
+		},
+	})
+}
+
+func testAccStackResourceConfig(description string, retentionSeconds int) string {
+	return fmt.Sprintf(`
+resource "influxdb_stack" "test" {
+  name        = "test-stack"
+  description = %[1]q
+  org_id      = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  env_refs = {
+    "bucket-name" = "test-stack-bucket"
+  }
+  templates = [
+    <<-EOT
+    apiVersion: influxdata.com/v2alpha1
+    kind: Bucket
+    metadata:
+      name: test-stack-bucket
+    spec:
+      name:
+        envRef:
+          key: bucket-name
+      retentionRules:
+        - type: expire
+          everySeconds: %[2]d
+    EOT
+  ]
+}
+`, description, retentionSeconds)
+}
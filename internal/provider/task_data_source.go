@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -66,6 +69,11 @@ func (d *TaskDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Required:    true,
 				Description: "The task ID.",
 			},
+			"label_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the labels attached to the task.",
+			},
 			"labels": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "The labels associated with the task.",
@@ -182,6 +190,8 @@ func (d *TaskDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_task")...)
+
 	d.client = client
 }
 
@@ -207,10 +217,12 @@ func (d *TaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Get all task using FindTask with empty filter
 	task, err := d.client.TasksAPI().GetTaskByID(ctx, taskID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Unable to list task",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeTasks),
+		))
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
@@ -18,6 +19,7 @@ type TaskModel struct {
 	Every           types.String `tfsdk:"every"`
 	Flux            types.String `tfsdk:"flux"`
 	Id              types.String `tfsdk:"id"`
+	LabelIDs        types.Set    `tfsdk:"label_ids"`
 	Labels          types.List   `tfsdk:"labels"`
 	LastRunError    types.String `tfsdk:"last_run_error"`
 	LastRunStatus   types.String `tfsdk:"last_run_status"`
@@ -32,6 +34,12 @@ type TaskModel struct {
 	UpdatedAt       types.String `tfsdk:"updated_at"`
 }
 
+// TaskResourceModel maps the task resource schema data, adding the timeouts to TaskModel.
+type TaskResourceModel struct {
+	TaskModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type TaskLinksModel struct {
 	Labels  types.String `tfsdk:"labels"`
 	Logs    types.String `tfsdk:"logs"`
@@ -45,6 +53,7 @@ type TaskLinksModel struct {
 func convertDomainTaskToModel(ctx context.Context, task *domain.Task) TaskModel {
 	// Convert labels if present
 	labelsList := convertLabelsToList(ctx, task.Labels)
+	labelIDs, _ := convertLabelsToIDSet(ctx, task.Labels)
 
 	// Convert links if present
 	linksObject := convertLinksToObject(task.Links)
@@ -57,6 +66,7 @@ func convertDomainTaskToModel(ctx context.Context, task *domain.Task) TaskModel
 		Every:           types.StringPointerValue(task.Every),
 		Flux:            types.StringValue(task.Flux),
 		Id:              types.StringValue(task.Id),
+		LabelIDs:        labelIDs,
 		Labels:          labelsList,
 		LastRunError:    types.StringPointerValue(task.LastRunError),
 		LastRunStatus:   convertTaskStatusToString(task.LastRunStatus),
@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -20,6 +26,7 @@ var (
 	_ resource.Resource                = &TaskResource{}
 	_ resource.ResourceWithImportState = &TaskResource{}
 	_ resource.ResourceWithImportState = &TaskResource{}
+	_ resource.ResourceWithModifyPlan  = &TaskResource{}
 )
 
 // NewTaskResource is a helper function to simplify the provider implementation.
@@ -72,6 +79,14 @@ func (r *TaskResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:    true,
 				Description: "The task ID.",
 			},
+			"label_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the labels attached to the task. When set, Terraform manages the full label set of the task and removes labels attached outside of Terraform.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
 			"labels": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "The labels associated with the task.",
@@ -152,8 +167,12 @@ func (r *TaskResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "The organization name. Specifies the organization that owns the task.",
 			},
 			"org_id": schema.StringAttribute{
-				Required:    true,
-				Description: "The organization ID. Specifies the organization that owns the task.",
+				Computed:    true,
+				Optional:    true,
+				Description: "The organization ID. Specifies the organization that owns the task. Defaults to the default organization of the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"owner_id": schema.StringAttribute{
 				Computed:    true,
@@ -173,12 +192,21 @@ func (r *TaskResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "The timestamp when the task was last updated.",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
+// ModifyPlan defaults org_id to the default organization of the provider.
+func (r *TaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDefaultOrgID(ctx, r.client, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *TaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan TaskModel
+	var plan TaskResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -186,22 +214,71 @@ func (r *TaskResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	createTaskResponse, err := r.client.TasksAPI().CreateTaskByFlux(ctx, plan.Flux.ValueString(), plan.OrgID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error creating task",
-			"Could not create task, unexpected error: "+err.Error(),
-		)
+			"Could not create task",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeTasks),
+		))
 
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan = convertDomainTaskToModel(ctx, createTaskResponse)
+	state := TaskResourceModel{
+		TaskModel: convertDomainTaskToModel(ctx, createTaskResponse),
+		Timeouts:  plan.Timeouts,
+	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.LabelIDs.IsNull() {
+		err = r.updateLabels(ctx, createTaskResponse.Id, types.SetNull(types.StringType), plan.LabelIDs)
+		if err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(
+				"Error adding labels to task",
+				"Could not add labels to task",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeTasks),
+			))
+
+			return
+		}
+
+		// Refresh the task so the computed labels reflect the attached labels
+		task, err := r.client.TasksAPI().GetTaskByID(ctx, createTaskResponse.Id)
+		if err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(
+				"Error reading task",
+				"",
+				err,
+				apierror.Permission(domain.PermissionActionRead, plan.OrgID.ValueString(), domain.ResourceTypeTasks),
+			))
+
+			return
+		}
+		state.TaskModel = convertDomainTaskToModel(ctx, task)
+	}
+	state.LabelIDs = plan.LabelIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -210,25 +287,43 @@ func (r *TaskResource) Create(ctx context.Context, req resource.CreateRequest, r
 // Read refreshes the Terraform state with the latest data.
 func (r *TaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state TaskModel
+	var state TaskResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get all task using FindTask with empty filter
 	task, err := r.client.TasksAPI().GetTaskByID(ctx, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Task not found",
-			err.Error(),
-		)
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading task",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeTasks),
+		))
 		return
 	}
 
 	// Map response body to model
-	state = convertDomainTaskToModel(ctx, task)
+	labelIDs := state.LabelIDs
+	state.TaskModel = convertDomainTaskToModel(ctx, task)
+	state.LabelIDs = refreshedLabelIDs(labelIDs, state.LabelIDs)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -239,8 +334,8 @@ func (r *TaskResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *TaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan TaskModel
-	var state TaskModel
+	var plan TaskResourceModel
+	var state TaskResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -248,6 +343,15 @@ func (r *TaskResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Read current state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -269,15 +373,44 @@ func (r *TaskResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update existing task
 	apiResponse, err := r.client.TasksAPI().UpdateTask(ctx, &updateTask)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error updating task",
-			"Could not update task, unexpected error: "+err.Error(),
-		)
+			"Could not update task",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeTasks),
+		))
+		return
+	}
+
+	err = r.updateLabels(ctx, state.Id.ValueString(), state.LabelIDs, plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating task labels",
+			"Could not update task labels",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeTasks),
+		))
 		return
 	}
 
+	// Refresh the task so the computed labels reflect the attached labels
+	if !plan.LabelIDs.Equal(state.LabelIDs) {
+		apiResponse, err = r.client.TasksAPI().GetTaskByID(ctx, state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(
+				"Error reading task",
+				"",
+				err,
+				apierror.Permission(domain.PermissionActionRead, plan.OrgID.ValueString(), domain.ResourceTypeTasks),
+			))
+			return
+		}
+	}
+
 	// Handle properties conversion based on the configuration
-	plan = convertDomainTaskToModel(ctx, apiResponse)
+	labelIDs := plan.LabelIDs
+	plan.TaskModel = convertDomainTaskToModel(ctx, apiResponse)
+	plan.LabelIDs = labelIDs
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -288,7 +421,7 @@ func (r *TaskResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *TaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state TaskModel
+	var state TaskResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -296,13 +429,24 @@ func (r *TaskResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing task
 	err := r.client.TasksAPI().DeleteTaskWithID(ctx, *state.Id.ValueStringPointer())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error deleting task",
-			"Could not delete task, unexpected error: "+err.Error(),
-		)
+			"Could not delete task",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeTasks),
+		))
 
 		return
 	}
@@ -325,9 +469,43 @@ func (r *TaskResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_task")...)
+
 	r.client = client
 }
 
 func (r *TaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// updateLabels attaches and detaches labels so the task carries exactly the desired label IDs
+func (r *TaskResource) updateLabels(ctx context.Context, taskID string, current types.Set, desired types.Set) error {
+	var currentIDs, desiredIDs []string
+	if !current.IsNull() && !current.IsUnknown() {
+		if diags := current.ElementsAs(ctx, &currentIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read current label IDs")
+		}
+	}
+	if !desired.IsNull() && !desired.IsUnknown() {
+		if diags := desired.ElementsAs(ctx, &desiredIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read desired label IDs")
+		}
+	}
+
+	toAdd, toRemove := diffIDs(currentIDs, desiredIDs)
+	for _, labelID := range toAdd {
+		_, err := r.client.TasksAPI().AddLabelWithID(ctx, taskID, labelID)
+		if err != nil {
+			return fmt.Errorf("failed to add label %s: %w", labelID, err)
+		}
+	}
+
+	for _, labelID := range toRemove {
+		err := r.client.TasksAPI().RemoveLabelWithID(ctx, taskID, labelID)
+		if err != nil && !apierror.IsNotFound(err) {
+			return fmt.Errorf("failed to remove label %s: %w", labelID, err)
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -37,6 +39,34 @@ func TestAccTaskResource(t *testing.T) {
 	})
 }
 
+func TestAccTaskResourceLabels(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccTaskResourceConfigLabels("[influxdb_label.first.id, influxdb_label.second.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_task.test_labels", "label_ids.#", "2"),
+					resource.TestCheckResourceAttr("influxdb_task.test_labels", "labels.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_task.test_labels", "label_ids.*", "influxdb_label.first", "id"),
+					resource.TestCheckTypeSetElemAttrPair("influxdb_task.test_labels", "label_ids.*", "influxdb_label.second", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccTaskResourceConfigLabels("[influxdb_label.second.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_task.test_labels", "label_ids.#", "1"),
+					resource.TestCheckResourceAttr("influxdb_task.test_labels", "labels.#", "1"),
+					resource.TestCheckResourceAttrPair("influxdb_task.test_labels", "labels.0.id", "influxdb_label.second", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTaskResourceValidation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -124,3 +154,34 @@ resource "influxdb_task" "test_basic" {
 }
 `
 }
+
+func testAccTaskResourceConfigLabels(labelIDs string) string {
+	return fmt.Sprintf(`
+resource "influxdb_label" "first" {
+  name   = "test-task-label-first"
+  org_id = %[1]q
+}
+
+resource "influxdb_label" "second" {
+  name   = "test-task-label-second"
+  org_id = %[1]q
+}
+
+resource "influxdb_task" "test_labels" {
+  org_id    = %[1]q
+  label_ids = %[2]s
+  flux      = <<-EOT
+    option task = {
+      name: "Labels Test Task",
+      every: 1h,
+    }
+
+    from(bucket: "test-bucket")
+      |> range(start: -1h)
+      |> filter(fn: (r) => r._measurement == "cpu")
+      |> mean()
+      |> to(bucket: "output-bucket", org: "test-org")
+  EOT
+}
+`, os.Getenv("INFLUXDB_ORG_ID"), labelIDs)
+}
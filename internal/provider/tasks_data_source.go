@@ -9,6 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -76,6 +79,11 @@ func (d *TasksDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 							Computed:    true,
 							Description: "The task ID.",
 						},
+						"label_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the labels attached to the task.",
+						},
 						"labels": schema.ListNestedAttribute{
 							Computed:    true,
 							Description: "The labels associated with the task.",
@@ -195,6 +203,8 @@ func (d *TasksDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_tasks")...)
+
 	d.client = client
 }
 
@@ -205,10 +215,12 @@ func (d *TasksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Get all tasks using FindTasks with empty filter
 	tasks, err := d.client.TasksAPI().FindTasks(ctx, &api.TaskFilter{})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Unable to list tasks",
-			err.Error(),
-		)
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeTasks),
+		))
 		return
 	}
 
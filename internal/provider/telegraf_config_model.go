@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// TelegrafConfigModel maps InfluxDB Telegraf configuration schema data.
+type TelegrafConfigModel struct {
+	Config          types.String   `tfsdk:"config"`
+	ConfigURL       types.String   `tfsdk:"config_url"`
+	Description     types.String   `tfsdk:"description"`
+	Id              types.String   `tfsdk:"id"`
+	MetadataBuckets types.Set      `tfsdk:"metadata_buckets"`
+	Name            types.String   `tfsdk:"name"`
+	OrgID           types.String   `tfsdk:"org_id"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// convertModelToTelegrafRequest converts a TelegrafConfigModel to a domain.TelegrafPluginRequest.
+func convertModelToTelegrafRequest(ctx context.Context, plan TelegrafConfigModel) (domain.TelegrafPluginRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	buckets := []string{}
+	if !plan.MetadataBuckets.IsNull() && !plan.MetadataBuckets.IsUnknown() {
+		diags.Append(plan.MetadataBuckets.ElementsAs(ctx, &buckets, false)...)
+	}
+
+	request := domain.TelegrafPluginRequest{
+		Config:      plan.Config.ValueStringPointer(),
+		Description: plan.Description.ValueStringPointer(),
+		Metadata: &struct {
+			Buckets *[]string `json:"buckets,omitempty"`
+		}{
+			Buckets: &buckets,
+		},
+		Name:  plan.Name.ValueStringPointer(),
+		OrgID: plan.OrgID.ValueStringPointer(),
+	}
+
+	return request, diags
+}
+
+// convertDomainTelegrafToModel converts a domain.Telegraf to TelegrafConfigModel.
+// configURL is the URL Telegraf agents fetch the configuration from.
+func convertDomainTelegrafToModel(ctx context.Context, telegraf *domain.Telegraf, configURL string) (TelegrafConfigModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	buckets := types.SetNull(types.StringType)
+	if telegraf.Metadata != nil && telegraf.Metadata.Buckets != nil && len(*telegraf.Metadata.Buckets) > 0 {
+		buckets, diags = types.SetValueFrom(ctx, types.StringType, *telegraf.Metadata.Buckets)
+	}
+
+	return TelegrafConfigModel{
+		Config:          types.StringPointerValue(telegraf.Config),
+		ConfigURL:       types.StringValue(configURL),
+		Description:     types.StringPointerValue(telegraf.Description),
+		Id:              types.StringPointerValue(telegraf.Id),
+		MetadataBuckets: buckets,
+		Name:            types.StringPointerValue(telegraf.Name),
+		OrgID:           types.StringPointerValue(telegraf.OrgID),
+	}, diags
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &TelegrafConfigResource{}
+	_ resource.ResourceWithImportState    = &TelegrafConfigResource{}
+	_ resource.ResourceWithValidateConfig = &TelegrafConfigResource{}
+)
+
+// NewTelegrafConfigResource is a helper function to simplify the provider implementation.
+func NewTelegrafConfigResource() resource.Resource {
+	return &TelegrafConfigResource{}
+}
+
+// TelegrafConfigResource defines the resource implementation.
+type TelegrafConfigResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *TelegrafConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_telegraf_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *TelegrafConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a Telegraf configuration. Telegraf agents load the configuration from `config_url`, e.g. `telegraf --config <config_url>` with `INFLUX_TOKEN` set.",
+
+		Attributes: map[string]schema.Attribute{
+			"config": schema.StringAttribute{
+				Required:    true,
+				Description: "The Telegraf configuration in TOML format. The configuration is parsed during plan and syntax errors are reported.",
+			},
+			"config_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL Telegraf agents fetch the configuration from.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the Telegraf configuration.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Telegraf configuration ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metadata_buckets": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The names of the buckets the configuration writes to.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Telegraf configuration.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates that the Telegraf configuration is valid TOML.
+func (r *TelegrafConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("config"), &config)...)
+	if resp.Diagnostics.HasError() || config.IsNull() || config.IsUnknown() {
+		return
+	}
+
+	var parsed map[string]any
+	if _, err := toml.Decode(config.ValueString(), &parsed); err != nil {
+		detail := err.Error()
+		var parseErr toml.ParseError
+		if errors.As(err, &parseErr) {
+			detail = parseErr.ErrorWithPosition()
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config"),
+			"Invalid Telegraf configuration",
+			"The Telegraf configuration is not valid TOML:\n\n"+detail,
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *TelegrafConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TelegrafConfigModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createTelegraf, diags := convertModelToTelegrafRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponse, err := r.client.APIClient().PostTelegrafs(ctx, &domain.PostTelegrafsAllParams{
+		Body: domain.PostTelegrafsJSONRequestBody(createTelegraf),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating Telegraf configuration",
+			"Could not create Telegraf configuration",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeTelegrafs),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state, diags := convertDomainTelegrafToModel(ctx, apiResponse, r.configURL(*apiResponse.Id))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *TelegrafConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state TelegrafConfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed Telegraf configuration value from InfluxDB
+	accept := domain.GetTelegrafsIDParamsAccept("application/json")
+	telegraf, err := r.client.APIClient().GetTelegrafsID(ctx, &domain.GetTelegrafsIDAllParams{
+		GetTelegrafsIDParams: domain.GetTelegrafsIDParams{Accept: &accept},
+		TelegrafID:           state.Id.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading Telegraf configuration",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeTelegrafs),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	newState, diags := convertDomainTelegrafToModel(ctx, telegraf, r.configURL(*telegraf.Id))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.Timeouts = state.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *TelegrafConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TelegrafConfigModel
+	var state TelegrafConfigModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateTelegraf, diags := convertModelToTelegrafRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing Telegraf configuration
+	apiResponse, err := r.client.APIClient().PutTelegrafsID(ctx, &domain.PutTelegrafsIDAllParams{
+		TelegrafID: state.Id.ValueString(),
+		Body:       domain.PutTelegrafsIDJSONRequestBody(updateTelegraf),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating Telegraf configuration",
+			"Could not update Telegraf configuration",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeTelegrafs),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	newState, diags := convertDomainTelegrafToModel(ctx, apiResponse, r.configURL(state.Id.ValueString()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.Timeouts = plan.Timeouts
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *TelegrafConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TelegrafConfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing Telegraf configuration
+	err := r.client.APIClient().DeleteTelegrafsID(ctx, &domain.DeleteTelegrafsIDAllParams{
+		TelegrafID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting Telegraf configuration",
+			"Could not delete Telegraf configuration",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeTelegrafs),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *TelegrafConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_telegraf_config")...)
+
+	r.client = client
+}
+
+func (r *TelegrafConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// configURL returns the URL Telegraf agents fetch the configuration from
+func (r *TelegrafConfigResource) configURL(telegrafID string) string {
+	return r.client.HTTPService().ServerAPIURL() + "telegrafs/" + telegrafID
+}
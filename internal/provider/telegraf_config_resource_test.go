@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTelegrafConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccTelegrafConfigResourceConfig("test-telegraf", "10s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_telegraf_config.test", "id"),
+					resource.TestCheckResourceAttrSet("influxdb_telegraf_config.test", "config_url"),
+					resource.TestCheckResourceAttr("influxdb_telegraf_config.test", "name", "test-telegraf"),
+					resource.TestCheckResourceAttr("influxdb_telegraf_config.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttr("influxdb_telegraf_config.test", "metadata_buckets.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_telegraf_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccTelegrafConfigResourceConfig("test-telegraf-updated", "30s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_telegraf_config.test", "name", "test-telegraf-updated"),
+					resource.TestMatchResourceAttr("influxdb_telegraf_config.test", "config", regexp.MustCompile(`interval = "30s"`)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTelegrafConfigResourceInvalidTOML(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "influxdb_telegraf_config" "test" {
+  name   = "test-telegraf-invalid"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  config = "[agent\ninterval = 10s"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Telegraf configuration"),
+			},
+		},
+	})
+}
+
+func testAccTelegrafConfigResourceConfig(name string, interval string) string {
+	return fmt.Sprintf(`
+resource "influxdb_telegraf_config" "test" {
+  name             = %[1]q
+  description      = "Telegraf configuration for acceptance tests"
+  org_id           = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  metadata_buckets = ["telegraf"]
+  config           = <<-EOT
+    [agent]
+      interval = %[2]q
+
+    [[outputs.influxdb_v2]]
+      urls         = ["$INFLUX_HOST"]
+      token        = "$INFLUX_TOKEN"
+      organization = "IoT"
+      bucket       = "telegraf"
+
+    [[inputs.cpu]]
+      percpu   = true
+      totalcpu = true
+  EOT
+}
+`, name, interval)
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	"gopkg.in/yaml.v3"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &TemplateExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &TemplateExportDataSource{}
+)
+
+// templateExportKinds are the resource kinds that can be exported.
+var templateExportKinds = []string{
+	string(domain.TemplateKindBucket),
+	string(domain.TemplateKindCheck),
+	string(domain.TemplateKindCheckDeadman),
+	string(domain.TemplateKindCheckThreshold),
+	string(domain.TemplateKindDashboard),
+	string(domain.TemplateKindLabel),
+	string(domain.TemplateKindNotificationEndpoint),
+	string(domain.TemplateKindNotificationEndpointHTTP),
+	string(domain.TemplateKindNotificationEndpointPagerDuty),
+	string(domain.TemplateKindNotificationEndpointSlack),
+	string(domain.TemplateKindNotificationRule),
+	string(domain.TemplateKindTask),
+	string(domain.TemplateKindTelegraf),
+	string(domain.TemplateKindVariable),
+}
+
+// templateKindLabel is the kind of label template objects.
+const templateKindLabel = string(domain.TemplateKindLabel)
+
+// templateExportJSON is the request body of /api/v2/templates/export.
+// The generated domain.TemplateExportByID nests anonymous structs, so the request is sent with this struct instead.
+type templateExportJSON struct {
+	OrgIDs []templateExportOrgJSON `json:"orgIDs"`
+}
+
+// templateExportOrgJSON selects the resources of an organization to export.
+type templateExportOrgJSON struct {
+	OrgID           string                    `json:"orgID"`
+	ResourceFilters templateExportFiltersJSON `json:"resourceFilters"`
+}
+
+// templateExportFiltersJSON filters the exported resources of an organization.
+type templateExportFiltersJSON struct {
+	ByLabel        []string `json:"byLabel,omitempty"`
+	ByResourceKind []string `json:"byResourceKind,omitempty"`
+}
+
+// NewTemplateExportDataSource is a helper function to simplify the provider implementation.
+func NewTemplateExportDataSource() datasource.DataSource {
+	return &TemplateExportDataSource{}
+}
+
+// TemplateExportDataSource is the data source implementation.
+type TemplateExportDataSource struct {
+	client influxdb2.Client
+}
+
+// TemplateExportDataSourceModel describes the data source data model.
+type TemplateExportDataSourceModel struct {
+	JSON          types.String   `tfsdk:"json"`
+	Labels        []types.String `tfsdk:"labels"`
+	Names         []types.String `tfsdk:"names"`
+	OrgID         types.String   `tfsdk:"org_id"`
+	ResourceKinds []types.String `tfsdk:"resource_kinds"`
+	YAML          types.String   `tfsdk:"yaml"`
+}
+
+// Metadata returns the data source type name.
+func (d *TemplateExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *TemplateExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Export the resources of an organization as an InfluxDB template manifest, like `influx export`. The manifest can be applied with `influxdb_stack`.",
+
+		Attributes: map[string]schema.Attribute{
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The template manifest in JSON format.",
+			},
+			"labels": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only export resources with one of these label names.",
+			},
+			"names": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only export resources with one of these names. Labels associated with the exported resources are kept.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the organization to export.",
+			},
+			"resource_kinds": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only export resources of these kinds. Valid values are `" + strings.Join(templateExportKinds, "`, `") + "`.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(templateExportKinds...)),
+				},
+			},
+			"yaml": schema.StringAttribute{
+				Computed:    true,
+				Description: "The template manifest in YAML format, one document per resource.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TemplateExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_template_export")...)
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *TemplateExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TemplateExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from configuration
+	filters := templateExportFiltersJSON{}
+	if len(state.Labels) > 0 {
+		filters.ByLabel = stringValues(state.Labels)
+	}
+	if len(state.ResourceKinds) > 0 {
+		filters.ByResourceKind = stringValues(state.ResourceKinds)
+	}
+
+	exportRequest := templateExportJSON{
+		OrgIDs: []templateExportOrgJSON{
+			{
+				OrgID:           state.OrgID.ValueString(),
+				ResourceFilters: filters,
+			},
+		},
+	}
+
+	var objects []map[string]any
+	err := doAPIRequest(ctx, d.client, nethttp.MethodPost, "templates/export", exportRequest, &objects)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Unable to export template",
+			"",
+			err,
+			"",
+		))
+
+		return
+	}
+
+	// Map response body to model
+	if objects == nil {
+		objects = []map[string]any{}
+	}
+	if len(state.Names) > 0 {
+		objects = filterTemplateObjectsByName(objects, stringValues(state.Names))
+	}
+
+	manifestJSON, err := json.MarshalIndent(objects, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to render template as JSON",
+			"Could not render the exported template as JSON: "+err.Error(),
+		)
+
+		return
+	}
+
+	manifestYAML, err := renderTemplateYAML(objects)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to render template as YAML",
+			"Could not render the exported template as YAML: "+err.Error(),
+		)
+
+		return
+	}
+
+	state.JSON = types.StringValue(string(manifestJSON))
+	state.YAML = types.StringValue(manifestYAML)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// filterTemplateObjectsByName keeps the template objects whose spec.name is one of names,
+// plus the labels associated with them.
+func filterTemplateObjectsByName(objects []map[string]any, names []string) []map[string]any {
+	kept := map[int]bool{}
+	associatedLabels := map[string]bool{}
+
+	for i, object := range objects {
+		spec, _ := object["spec"].(map[string]any)
+		name, _ := spec["name"].(string)
+		if !slices.Contains(names, name) {
+			continue
+		}
+		kept[i] = true
+
+		associations, _ := spec["associations"].([]any)
+		for _, association := range associations {
+			association, _ := association.(map[string]any)
+			if association["kind"] == templateKindLabel {
+				labelName, _ := association["name"].(string)
+				associatedLabels[labelName] = true
+			}
+		}
+	}
+
+	filtered := []map[string]any{}
+	for i, object := range objects {
+		metadata, _ := object["metadata"].(map[string]any)
+		metaName, _ := metadata["name"].(string)
+		if kept[i] || (object["kind"] == templateKindLabel && associatedLabels[metaName]) {
+			filtered = append(filtered, object)
+		}
+	}
+
+	return filtered
+}
+
+// renderTemplateYAML renders template objects as YAML documents.
+// The objects are converted through JSON so the YAML has the same key order and number formatting.
+func renderTemplateYAML(objects []map[string]any) (string, error) {
+	var buffer bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+
+	for _, object := range objects {
+		objectJSON, err := json.Marshal(object)
+		if err != nil {
+			return "", err
+		}
+
+		var node yaml.Node
+		if err := yaml.Unmarshal(objectJSON, &node); err != nil {
+			return "", err
+		}
+		clearYAMLStyle(&node)
+
+		if err := encoder.Encode(&node); err != nil {
+			return "", err
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// clearYAMLStyle resets the flow and quoting style JSON is parsed with, so the node renders as block YAML.
+// Strings that would be read back as another type are still quoted by the encoder.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
+
+// stringValues returns the values of a list of strings.
+func stringValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
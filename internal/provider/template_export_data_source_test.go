@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTemplateExportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + testAccTemplateExportDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.influxdb_template_export.test", "json", regexp.MustCompile(`"name": "test-template-export-bucket"`)),
+					resource.TestMatchResourceAttr("data.influxdb_template_export.test", "json", regexp.MustCompile(`"name": "test-template-export-label"`)),
+					resource.TestMatchResourceAttr("data.influxdb_template_export.test", "yaml", regexp.MustCompile(`kind: Bucket`)),
+					resource.TestMatchResourceAttr("data.influxdb_template_export.test", "yaml", regexp.MustCompile(`name: test-template-export-bucket`)),
+					resource.TestMatchResourceAttr("data.influxdb_template_export.by_label", "json", regexp.MustCompile(`"name": "test-template-export-bucket"`)),
+				),
+			},
+		},
+	})
+}
+
+var testAccTemplateExportDataSourceConfig = `
+resource "influxdb_label" "test" {
+  name   = "test-template-export-label"
+  org_id = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+}
+
+resource "influxdb_bucket" "test" {
+  name      = "test-template-export-bucket"
+  org_id    = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  label_ids = [influxdb_label.test.id]
+}
+
+data "influxdb_template_export" "test" {
+  org_id         = influxdb_bucket.test.org_id
+  resource_kinds = ["Bucket", "Label"]
+  names          = [influxdb_bucket.test.name]
+}
+
+data "influxdb_template_export" "by_label" {
+  org_id         = influxdb_bucket.test.org_id
+  resource_kinds = ["Bucket"]
+  labels         = [influxdb_label.test.name]
+}
+`
@@ -0,0 +1,15 @@
+package provider
+
+import "time"
+
+// Default operation timeouts of the resources, used when the timeouts block does not set them.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
+// defaultRequestTimeout is the default timeout of a request to InfluxDB including its retries.
+// It leaves room for the default retries, whose waits add up to at most 15s.
+const defaultRequestTimeout = 30 * time.Second
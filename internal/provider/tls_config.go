@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// providerTLSSettings are the TLS settings of the provider configuration.
+type providerTLSSettings struct {
+	CACertFile         string
+	CACertPEM          string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+// buildTLSConfig returns the TLS configuration of the InfluxDB client,
+// or nil if none of the TLS settings are set and the default configuration applies.
+func buildTLSConfig(settings providerTLSSettings) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if settings == (providerTLSSettings{}) {
+		return nil, diags
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: settings.InsecureSkipVerify,
+	}
+
+	// Trust the CA certificates in addition to the system certificates
+	if settings.CACertFile != "" || settings.CACertPEM != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if settings.CACertFile != "" {
+			caCert, err := os.ReadFile(settings.CACertFile)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Invalid CA Certificate File",
+					"The provider cannot read the CA certificate file: "+err.Error(),
+				)
+			} else if !rootCAs.AppendCertsFromPEM(caCert) {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Invalid CA Certificate File",
+					"The CA certificate file "+settings.CACertFile+" does not contain any PEM-encoded certificate.",
+				)
+			}
+		}
+
+		if settings.CACertPEM != "" && !rootCAs.AppendCertsFromPEM([]byte(settings.CACertPEM)) {
+			diags.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid CA Certificate",
+				"The CA certificate does not contain any PEM-encoded certificate.",
+			)
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	// Authenticate with the client certificate
+	if settings.ClientCert != "" || settings.ClientKey != "" {
+		if settings.ClientCert == "" {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Missing Client Certificate",
+				"The client certificate is required when a client key is set.",
+			)
+		} else if !containsPEMBlock(settings.ClientCert, "CERTIFICATE") {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid Client Certificate",
+				"The client certificate does not contain any PEM-encoded certificate.",
+			)
+		}
+
+		if settings.ClientKey == "" {
+			diags.AddAttributeError(
+				path.Root("client_key"),
+				"Missing Client Key",
+				"The client key is required when a client certificate is set.",
+			)
+		} else if !containsPEMBlock(settings.ClientKey, "") {
+			diags.AddAttributeError(
+				path.Root("client_key"),
+				"Invalid Client Key",
+				"The client key is not PEM-encoded.",
+			)
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		clientCert, err := tls.X509KeyPair([]byte(settings.ClientCert), []byte(settings.ClientKey))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_key"),
+				"Invalid Client Key",
+				"The client key does not match the client certificate: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return tlsConfig, diags
+}
+
+// containsPEMBlock reports whether data contains a PEM block of the given type, or of any type if blockType is empty.
+func containsPEMBlock(data string, blockType string) bool {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return false
+		}
+		if blockType == "" || block.Type == blockType {
+			return true
+		}
+	}
+}
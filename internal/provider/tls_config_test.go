@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestBuildTLSConfig(t *testing.T) {
+	certPEM, keyPEM := testGenerateCertificate(t)
+	otherCertPEM, _ := testGenerateCertificate(t)
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(certPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		settings      providerTLSSettings
+		expectNil     bool
+		expectedPaths []path.Path
+	}{
+		"empty": {
+			settings:  providerTLSSettings{},
+			expectNil: true,
+		},
+		"valid": {
+			settings: providerTLSSettings{
+				CACertFile: caCertFile,
+				CACertPEM:  certPEM,
+				ClientCert: certPEM,
+				ClientKey:  keyPEM,
+			},
+		},
+		"insecure skip verify": {
+			settings: providerTLSSettings{InsecureSkipVerify: true},
+		},
+		"missing CA certificate file": {
+			settings:      providerTLSSettings{CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+			expectedPaths: []path.Path{path.Root("ca_cert_file")},
+		},
+		"invalid CA certificate": {
+			settings:      providerTLSSettings{CACertPEM: "not a certificate"},
+			expectedPaths: []path.Path{path.Root("ca_cert_pem")},
+		},
+		"client certificate without key": {
+			settings:      providerTLSSettings{ClientCert: certPEM},
+			expectedPaths: []path.Path{path.Root("client_key")},
+		},
+		"invalid client certificate": {
+			settings:      providerTLSSettings{ClientCert: keyPEM, ClientKey: keyPEM},
+			expectedPaths: []path.Path{path.Root("client_cert")},
+		},
+		"mismatched client key": {
+			settings:      providerTLSSettings{ClientCert: otherCertPEM, ClientKey: keyPEM},
+			expectedPaths: []path.Path{path.Root("client_key")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tlsConfig, diags := buildTLSConfig(testCase.settings)
+
+			if len(testCase.expectedPaths) == 0 {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				if testCase.expectNil != (tlsConfig == nil) {
+					t.Fatalf("expected nil TLS config: %t, got: %v", testCase.expectNil, tlsConfig)
+				}
+				return
+			}
+
+			var paths []path.Path
+			for _, d := range diags.Errors() {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					paths = append(paths, withPath.Path())
+				}
+			}
+			if len(paths) != len(testCase.expectedPaths) {
+				t.Fatalf("expected errors on %v, got: %v", testCase.expectedPaths, diags)
+			}
+			for i := range paths {
+				if !paths[i].Equal(testCase.expectedPaths[i]) {
+					t.Errorf("expected error on %s, got: %s", testCase.expectedPaths[i], paths[i])
+				}
+			}
+		})
+	}
+}
+
+// testGenerateCertificate returns a PEM-encoded self-signed certificate and its private key.
+func testGenerateCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "influxdb"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
 )
 
 // UserModel maps InfluxDB User schema data.
@@ -13,3 +15,53 @@ type UserModel struct {
 	OrgRole  types.String `tfsdk:"org_role"`
 	Status   types.String `tfsdk:"status"`
 }
+
+// UserResourceModel maps the user resource schema data, adding the timeouts to UserModel.
+type UserResourceModel struct {
+	UserModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// resourceMembersToUsers returns the users of a members response
+func resourceMembersToUsers(members *[]domain.ResourceMember) []domain.UserResponse {
+	if members == nil {
+		return nil
+	}
+
+	users := make([]domain.UserResponse, 0, len(*members))
+	for _, member := range *members {
+		users = append(users, member.UserResponse)
+	}
+	return users
+}
+
+// resourceOwnersToUsers returns the users of an owners response
+func resourceOwnersToUsers(owners *[]domain.ResourceOwner) []domain.UserResponse {
+	if owners == nil {
+		return nil
+	}
+
+	users := make([]domain.UserResponse, 0, len(*owners))
+	for _, owner := range *owners {
+		users = append(users, owner.UserResponse)
+	}
+	return users
+}
+
+// findUserResponse returns the user with the given ID, or nil if it is not in the list
+func findUserResponse(users []domain.UserResponse, userID string) *domain.UserResponse {
+	for i := range users {
+		if users[i].Id != nil && *users[i].Id == userID {
+			return &users[i]
+		}
+	}
+	return nil
+}
+
+// roleWithArticle returns a member or owner role with its indefinite article
+func roleWithArticle(role string) string {
+	if role == "owner" {
+		return "an owner"
+	}
+	return "a member"
+}
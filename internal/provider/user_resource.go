@@ -3,8 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -88,12 +90,16 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan UserModel
+	var plan UserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -101,6 +107,15 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan
 	status := domain.UserStatus(plan.Status.ValueString())
 	createUser := domain.User{
@@ -111,10 +126,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Convert properties map to domain format if provided
 	createUserResponse, err := r.client.UsersAPI().CreateUser(ctx, &createUser)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error creating user",
-			"Could not create user, unexpected error: "+err.Error(),
-		)
+			"Could not create user",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeUsers),
+		))
 
 		return
 	}
@@ -127,10 +144,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Update the user with the password
 	err = r.client.UsersAPI().UpdateUserPasswordWithID(ctx, plan.Id.ValueString(), plan.Password.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error setting user password",
-			"Could not set user password, unexpected error: "+err.Error(),
-		)
+			"Could not set user password",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeUsers),
+		))
 		return
 	}
 	// Update the plan with the password value
@@ -141,10 +160,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		orgRole := plan.OrgRole.ValueString()
 		err = r.manageOrgMembership(ctx, plan.Id.ValueString(), "", plan.OrgId.ValueString(), "", orgRole)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apierror.Diagnostic(
 				"Error managing organization membership",
-				"Could not manage organization membership, unexpected error: "+err.Error(),
-			)
+				"Could not manage organization membership",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeUsers),
+			))
 			return
 		}
 	}
@@ -159,7 +180,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 // Read refreshes the Terraform state with the latest data.
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state UserModel
+	var state UserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -167,19 +188,35 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed user value from InfluxDB
 	user, err := r.client.UsersAPI().FindUserByID(ctx, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"User not found",
-			err.Error(),
-		)
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading user",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeUsers),
+		))
 
 		return
 	}
 
 	// Overwrite items with refreshed state
-	state = UserModel{
+	state.UserModel = UserModel{
 		Id:       types.StringPointerValue(user.Id),
 		Name:     types.StringValue(user.Name),
 		Status:   types.StringValue(string(*user.Status)),
@@ -197,8 +234,8 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan UserModel
-	var state UserModel
+	var plan UserResourceModel
+	var state UserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -206,6 +243,15 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Read current state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -223,10 +269,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update existing user
 	apiResponse, err := r.client.UsersAPI().UpdateUser(ctx, &updateUser)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error updating user",
-			"Could not update user, unexpected error: "+err.Error(),
-		)
+			"Could not update user",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeUsers),
+		))
 		return
 	}
 
@@ -239,10 +287,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !plan.Password.Equal(state.Password) {
 		err = r.client.UsersAPI().UpdateUserPasswordWithID(ctx, plan.Id.ValueString(), plan.Password.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apierror.Diagnostic(
 				"Error updating user password",
-				"Could not update user password, unexpected error: "+err.Error(),
-			)
+				"Could not update user password",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeUsers),
+			))
 			return
 		}
 	}
@@ -272,10 +322,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if oldOrgId != newOrgId || oldRole != newRole {
 		err = r.manageOrgMembership(ctx, plan.Id.ValueString(), oldOrgId, newOrgId, oldRole, newRole)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apierror.Diagnostic(
 				"Error managing organization membership",
-				"Could not manage organization membership, unexpected error: "+err.Error(),
-			)
+				"Could not manage organization membership",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeUsers),
+			))
 			return
 		}
 	}
@@ -289,7 +341,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state UserModel
+	var state UserResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -297,6 +349,15 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Remove user from organization if they were a member
 	if !state.OrgId.IsNull() && !state.OrgRole.IsNull() {
 		orgRole := state.OrgRole.ValueString()
@@ -313,10 +374,12 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	// Delete existing user
 	err := r.client.UsersAPI().DeleteUserWithID(ctx, *state.Id.ValueStringPointer())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apierror.Diagnostic(
 			"Error deleting user",
-			"Could not delete user, unexpected error: "+err.Error(),
-		)
+			"Could not delete user",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, "", domain.ResourceTypeUsers),
+		))
 
 		return
 	}
@@ -339,6 +402,8 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_user")...)
+
 	r.client = client
 }
 
@@ -355,7 +420,7 @@ func (r *UserResource) manageOrgMembership(ctx context.Context, userID string, o
 			err := r.client.OrganizationsAPI().RemoveOwnerWithID(ctx, oldOrgID, userID)
 			if err != nil {
 				// Don't fail if user is not found in organization (might already be removed)
-				if !isNotFoundError(err) {
+				if !apierror.IsNotFound(err) {
 					return fmt.Errorf("failed to remove user as owner from organization %s: %w", oldOrgID, err)
 				}
 			}
@@ -363,7 +428,7 @@ func (r *UserResource) manageOrgMembership(ctx context.Context, userID string, o
 			err := r.client.OrganizationsAPI().RemoveMemberWithID(ctx, oldOrgID, userID)
 			if err != nil {
 				// Don't fail if user is not found in organization (might already be removed)
-				if !isNotFoundError(err) {
+				if !apierror.IsNotFound(err) {
 					return fmt.Errorf("failed to remove user as member from organization %s: %w", oldOrgID, err)
 				}
 			}
@@ -379,7 +444,7 @@ func (r *UserResource) manageOrgMembership(ctx context.Context, userID string, o
 				err := r.client.OrganizationsAPI().RemoveOwnerWithID(ctx, oldOrgID, userID)
 				if err != nil {
 					// Don't fail if user is not found in organization (might already be removed)
-					if !isNotFoundError(err) {
+					if !apierror.IsNotFound(err) {
 						return fmt.Errorf("failed to remove user as owner from organization %s: %w", oldOrgID, err)
 					}
 				}
@@ -387,7 +452,7 @@ func (r *UserResource) manageOrgMembership(ctx context.Context, userID string, o
 				err := r.client.OrganizationsAPI().RemoveMemberWithID(ctx, oldOrgID, userID)
 				if err != nil {
 					// Don't fail if user is not found in organization (might already be removed)
-					if !isNotFoundError(err) {
+					if !apierror.IsNotFound(err) {
 						return fmt.Errorf("failed to remove user as member from organization %s: %w", oldOrgID, err)
 					}
 				}
@@ -413,13 +478,3 @@ func (r *UserResource) manageOrgMembership(ctx context.Context, userID string, o
 
 	return nil
 }
-
-// isNotFoundError checks if the error is a 404 not found error
-func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
-	// Check for common "not found" error patterns
-	errMsg := err.Error()
-	return strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "404")
-}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// V1AuthorizationModel maps InfluxDB v1 (legacy) authorization schema data.
+type V1AuthorizationModel struct {
+	CreatedAt       types.String                   `tfsdk:"created_at"`
+	Description     types.String                   `tfsdk:"description"`
+	Id              types.String                   `tfsdk:"id"`
+	Org             types.String                   `tfsdk:"org"`
+	OrgID           types.String                   `tfsdk:"org_id"`
+	Password        types.String                   `tfsdk:"password"`
+	PasswordVersion types.Int64                    `tfsdk:"password_version"`
+	Permissions     []AuthorizationPermissionModel `tfsdk:"permissions"`
+	Status          types.String                   `tfsdk:"status"`
+	Timeouts        timeouts.Value                 `tfsdk:"timeouts"`
+	Token           types.String                   `tfsdk:"token"`
+	UpdatedAt       types.String                   `tfsdk:"updated_at"`
+	UserID          types.String                   `tfsdk:"user_id"`
+}
+
+// v1AuthorizationCreateJSON is the request body of a v1 authorization. Unlike v2
+// authorizations the token is chosen by the caller and acts as the v1 username.
+type v1AuthorizationCreateJSON struct {
+	domain.AuthorizationPostRequest
+	Token *string `json:"token,omitempty"`
+}
+
+// v1AuthorizationPasswordJSON is the request body for setting a v1 authorization password.
+type v1AuthorizationPasswordJSON struct {
+	Password string `json:"password"`
+}
+
+// convertModelToV1AuthorizationRequest converts a V1AuthorizationModel to the v1 authorization request body.
+func convertModelToV1AuthorizationRequest(plan V1AuthorizationModel) v1AuthorizationCreateJSON {
+	permissions := []domain.Permission{}
+	for _, permissionData := range plan.Permissions {
+		permissions = append(permissions, domain.Permission{
+			Action: domain.PermissionAction(permissionData.Action.ValueString()),
+			Resource: domain.Resource{
+				Id:    permissionData.Resource.Id.ValueStringPointer(),
+				Type:  domain.ResourceType(permissionData.Resource.Type.ValueString()),
+				OrgID: plan.OrgID.ValueStringPointer(),
+			},
+		})
+	}
+
+	status := domain.AuthorizationUpdateRequestStatus(plan.Status.ValueString())
+
+	return v1AuthorizationCreateJSON{
+		AuthorizationPostRequest: domain.AuthorizationPostRequest{
+			AuthorizationUpdateRequest: domain.AuthorizationUpdateRequest{
+				Description: plan.Description.ValueStringPointer(),
+				Status:      &status,
+			},
+			OrgID:       plan.OrgID.ValueStringPointer(),
+			Permissions: &permissions,
+			UserID:      plan.UserID.ValueStringPointer(),
+		},
+		Token: plan.Token.ValueStringPointer(),
+	}
+}
+
+// convertV1AuthorizationToModel converts a v1 authorization to V1AuthorizationModel.
+// The password is write-only and never returned, so it is taken from prior.
+func convertV1AuthorizationToModel(authorization *domain.Authorization, prior V1AuthorizationModel) V1AuthorizationModel {
+	model := V1AuthorizationModel{
+		CreatedAt:       convertTimeToString(authorization.CreatedAt),
+		Description:     types.StringValue(stringOrEmpty(authorization.Description)),
+		Id:              types.StringPointerValue(authorization.Id),
+		Org:             types.StringPointerValue(authorization.Org),
+		OrgID:           types.StringPointerValue(authorization.OrgID),
+		Password:        types.StringNull(),
+		PasswordVersion: prior.PasswordVersion,
+		Permissions:     []AuthorizationPermissionModel{},
+		Status:          types.StringNull(),
+		Timeouts:        prior.Timeouts,
+		Token:           types.StringPointerValue(authorization.Token),
+		UpdatedAt:       convertTimeToString(authorization.UpdatedAt),
+		UserID:          types.StringPointerValue(authorization.UserID),
+	}
+	if authorization.Status != nil {
+		model.Status = types.StringValue(string(*authorization.Status))
+	}
+	if authorization.Permissions != nil {
+		model.Permissions = getPermissions(*authorization.Permissions)
+	}
+
+	return model
+}
@@ -0,0 +1,448 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &V1AuthorizationResource{}
+	_ resource.ResourceWithImportState = &V1AuthorizationResource{}
+)
+
+// NewV1AuthorizationResource is a helper function to simplify the provider implementation.
+func NewV1AuthorizationResource() resource.Resource {
+	return &V1AuthorizationResource{}
+}
+
+// V1AuthorizationResource defines the resource implementation.
+type V1AuthorizationResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *V1AuthorizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_v1_authorization"
+}
+
+// Schema defines the schema for the resource.
+func (r *V1AuthorizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a v1 (legacy) authorization. InfluxDB v1 compatible clients authenticate with the `token` as username and the `password` using basic authentication. Requires Terraform 1.11 or later because `password` is write-only.",
+
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Authorization creation date.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "A description of the authorization.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The authorization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org": schema.StringAttribute{
+				Computed:    true,
+				Description: "Organization name. Specifies the organization that owns the authorization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "An organization ID. Specifies the organization that owns the authorization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The password of the authorization. The password is never stored in the Terraform state; change `password_version` to set a new password.",
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "A version for `password`. Changing it sets the password again.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "A list of bucket permissions for the authorization.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Required:    true,
+							Description: "Permission action. Valid values are `read` or `write`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"read", "write"}...),
+							},
+						},
+						"resource": schema.SingleNestedAttribute{
+							Required: true,
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Required:    true,
+									Description: "A bucket ID.",
+								},
+								"name": schema.StringAttribute{
+									Computed:    true,
+									Description: "The name of the bucket.",
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.UseStateForUnknown(),
+									},
+								},
+								"org": schema.StringAttribute{
+									Computed:    true,
+									Description: "An organization name. The organization that owns the bucket.",
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.UseStateForUnknown(),
+									},
+								},
+								"org_id": schema.StringAttribute{
+									Computed:    true,
+									Description: "An organization ID. Identifies the organization that owns the bucket.",
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.UseStateForUnknown(),
+									},
+								},
+								"type": schema.StringAttribute{
+									Required:    true,
+									Description: "A resource type. v1 authorizations only support `buckets`.",
+									Validators: []validator.String{
+										stringvalidator.OneOf(string(domain.ResourceTypeBuckets)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Status of the authorization. Valid values are `active` or `inactive`.",
+				Default:     stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"active", "inactive"}...),
+				},
+			},
+			"token": schema.StringAttribute{
+				Required:    true,
+				Description: "The token of the authorization. v1 compatible clients use it as username.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last authorization update date.",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "A user ID. Specifies the user that the authorization is scoped to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *V1AuthorizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan V1AuthorizationModel
+	var password types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	createAuthorization := convertModelToV1AuthorizationRequest(plan)
+
+	var apiResponse domain.Authorization
+	err := doLegacyAPIRequest(ctx, r.client, nethttp.MethodPost, "authorizations", createAuthorization, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating v1 authorization",
+			"Could not create v1 authorization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state := convertV1AuthorizationToModel(&apiResponse, plan)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !password.IsNull() {
+		err = r.setPassword(ctx, state.Id.ValueString(), password.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(
+				"Error setting v1 authorization password",
+				"Could not set v1 authorization password",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+			))
+
+			return
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *V1AuthorizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state V1AuthorizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed v1 authorization value from InfluxDB
+	var authorization domain.Authorization
+	err := doLegacyAPIRequest(ctx, r.client, nethttp.MethodGet, "authorizations/"+state.Id.ValueString(), nil, &authorization)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading v1 authorization",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state = convertV1AuthorizationToModel(&authorization, state)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *V1AuthorizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan V1AuthorizationModel
+	var state V1AuthorizationModel
+	var password types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attributes are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	status := domain.AuthorizationUpdateRequestStatus(plan.Status.ValueString())
+	updateAuthorization := domain.AuthorizationUpdateRequest{
+		Description: plan.Description.ValueStringPointer(),
+		Status:      &status,
+	}
+
+	// Update existing v1 authorization
+	var apiResponse domain.Authorization
+	err := doLegacyAPIRequest(ctx, r.client, nethttp.MethodPatch, "authorizations/"+state.Id.ValueString(), updateAuthorization, &apiResponse)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating v1 authorization",
+			"Could not update v1 authorization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
+
+		return
+	}
+
+	if !password.IsNull() && !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		err = r.setPassword(ctx, state.Id.ValueString(), password.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apierror.Diagnostic(
+				"Error setting v1 authorization password",
+				"Could not set v1 authorization password",
+				err,
+				apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+			))
+
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state = convertV1AuthorizationToModel(&apiResponse, plan)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *V1AuthorizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state V1AuthorizationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing v1 authorization
+	err := doLegacyAPIRequest(ctx, r.client, nethttp.MethodDelete, "authorizations/"+state.Id.ValueString(), nil, nil)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting v1 authorization",
+			"Could not delete v1 authorization",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeAuthorizations),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *V1AuthorizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_v1_authorization")...)
+
+	r.client = client
+}
+
+func (r *V1AuthorizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setPassword sets the password of the v1 authorization
+func (r *V1AuthorizationResource) setPassword(ctx context.Context, authorizationID string, password string) error {
+	return doLegacyAPIRequest(ctx, r.client, nethttp.MethodPost, "authorizations/"+authorizationID+"/password", v1AuthorizationPasswordJSON{Password: password}, nil)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccV1AuthorizationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccV1AuthorizationResourceConfig("Grafana InfluxQL", "active", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_v1_authorization.test", "id"),
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "token", "test-v1-user"),
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "description", "Grafana InfluxQL"),
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "status", "active"),
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "permissions.#", "2"),
+					resource.TestCheckResourceAttrPair("influxdb_v1_authorization.test", "permissions.0.resource.id", "influxdb_bucket.test", "id"),
+					resource.TestCheckNoResourceAttr("influxdb_v1_authorization.test", "password"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "influxdb_v1_authorization.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccV1AuthorizationResourceConfig("Grafana InfluxQL (disabled)", "inactive", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "description", "Grafana InfluxQL (disabled)"),
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "status", "inactive"),
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "password_version", "2"),
+				),
+			},
+			// Removing the description clears it
+			{
+				Config: providerConfig + testAccV1AuthorizationResourceConfig("", "inactive", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_v1_authorization.test", "description", ""),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccV1AuthorizationResourceConfig(description string, status string, passwordVersion int) string {
+	descriptionAttribute := ""
+	if description != "" {
+		descriptionAttribute = fmt.Sprintf("description      = %q", description)
+	}
+
+	return fmt.Sprintf(`
+resource "influxdb_bucket" "test" {
+  name   = "test-v1-authorization-bucket"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_v1_authorization" "test" {
+  token            = "test-v1-user"
+  password         = "test-v1-password-%[3]d"
+  password_version = %[3]d
+  %[1]s
+  status           = %[2]q
+  org_id           = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+
+  permissions = [
+    {
+      action = "read"
+      resource = {
+        id   = influxdb_bucket.test.id
+        type = "buckets"
+      }
+    },
+    {
+      action = "write"
+      resource = {
+        id   = influxdb_bucket.test.id
+        type = "buckets"
+      }
+    },
+  ]
+}
+`, descriptionAttribute, status, passwordVersion)
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+)
+
+// VariableModel maps InfluxDB variable schema data.
+type VariableModel struct {
+	ConstantValues types.List          `tfsdk:"constant_values"`
+	CreatedAt      types.String        `tfsdk:"created_at"`
+	Description    types.String        `tfsdk:"description"`
+	Id             types.String        `tfsdk:"id"`
+	LabelIDs       types.Set           `tfsdk:"label_ids"`
+	MapValues      types.Map           `tfsdk:"map_values"`
+	Name           types.String        `tfsdk:"name"`
+	OrgID          types.String        `tfsdk:"org_id"`
+	Query          *VariableQueryModel `tfsdk:"query"`
+	Selected       types.List          `tfsdk:"selected"`
+	Type           types.String        `tfsdk:"type"`
+	UpdatedAt      types.String        `tfsdk:"updated_at"`
+}
+
+// VariableResourceModel maps the variable resource schema data, adding the timeouts to VariableModel.
+type VariableResourceModel struct {
+	VariableModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// VariableQueryModel maps InfluxDB query variable schema data.
+type VariableQueryModel struct {
+	Language types.String `tfsdk:"language"`
+	Query    types.String `tfsdk:"query"`
+}
+
+// variableArgumentsJSON is the wire format of variable arguments.
+// domain.VariableProperties is an untyped interface, so the fields of the
+// query, constant and map argument types are decoded into this struct.
+type variableArgumentsJSON struct {
+	Type   string          `json:"type"`
+	Values json.RawMessage `json:"values"`
+}
+
+// convertModelToDomainVariable converts a VariableModel to a domain.Variable.
+func convertModelToDomainVariable(ctx context.Context, plan VariableModel) (domain.Variable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	variable := domain.Variable{
+		Description: plan.Description.ValueStringPointer(),
+		Name:        plan.Name.ValueString(),
+		OrgID:       plan.OrgID.ValueString(),
+	}
+
+	switch plan.Type.ValueString() {
+	case string(domain.QueryVariablePropertiesTypeQuery):
+		argumentType := domain.QueryVariablePropertiesTypeQuery
+		arguments := domain.QueryVariableProperties{
+			Type: &argumentType,
+			Values: &struct {
+				Language *string `json:"language,omitempty"`
+				Query    *string `json:"query,omitempty"`
+			}{},
+		}
+		if plan.Query != nil {
+			arguments.Values.Language = plan.Query.Language.ValueStringPointer()
+			arguments.Values.Query = plan.Query.Query.ValueStringPointer()
+		}
+		variable.Arguments = arguments
+	case string(domain.ConstantVariablePropertiesTypeConstant):
+		argumentType := domain.ConstantVariablePropertiesTypeConstant
+		values := []string{}
+		diags.Append(plan.ConstantValues.ElementsAs(ctx, &values, false)...)
+		variable.Arguments = domain.ConstantVariableProperties{
+			Type:   &argumentType,
+			Values: &values,
+		}
+	case string(domain.MapVariablePropertiesTypeMap):
+		argumentType := domain.MapVariablePropertiesTypeMap
+		values := map[string]string{}
+		diags.Append(plan.MapValues.ElementsAs(ctx, &values, false)...)
+		variable.Arguments = domain.MapVariableProperties{
+			Type:   &argumentType,
+			Values: &domain.MapVariableProperties_Values{AdditionalProperties: values},
+		}
+	}
+
+	if !plan.Selected.IsNull() && !plan.Selected.IsUnknown() {
+		var selected []string
+		diags.Append(plan.Selected.ElementsAs(ctx, &selected, false)...)
+		variable.Selected = &selected
+	}
+
+	return variable, diags
+}
+
+// convertDomainVariableToModel converts a domain.Variable to VariableModel.
+func convertDomainVariableToModel(ctx context.Context, variable *domain.Variable) (VariableModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := VariableModel{
+		ConstantValues: types.ListNull(types.StringType),
+		CreatedAt:      convertTimeToString(variable.CreatedAt),
+		Description:    types.StringPointerValue(variable.Description),
+		Id:             types.StringPointerValue(variable.Id),
+		MapValues:      types.MapNull(types.StringType),
+		Name:           types.StringValue(variable.Name),
+		OrgID:          types.StringValue(variable.OrgID),
+		Selected:       types.ListNull(types.StringType),
+		UpdatedAt:      convertTimeToString(variable.UpdatedAt),
+	}
+
+	// The generated client decodes the arguments into a generic map, so re-encode them
+	// and decode the values matching the argument type
+	rawArguments, err := json.Marshal(variable.Arguments)
+	if err != nil {
+		diags.AddError("Invalid variable arguments", err.Error())
+		return model, diags
+	}
+
+	var arguments variableArgumentsJSON
+	if err := json.Unmarshal(rawArguments, &arguments); err != nil {
+		diags.AddError("Invalid variable arguments", err.Error())
+		return model, diags
+	}
+	model.Type = types.StringValue(arguments.Type)
+
+	var valueDiags diag.Diagnostics
+	switch arguments.Type {
+	case string(domain.QueryVariablePropertiesTypeQuery):
+		var values struct {
+			Language *string `json:"language"`
+			Query    *string `json:"query"`
+		}
+		err = json.Unmarshal(arguments.Values, &values)
+		model.Query = &VariableQueryModel{
+			Language: types.StringPointerValue(values.Language),
+			Query:    types.StringPointerValue(values.Query),
+		}
+	case string(domain.ConstantVariablePropertiesTypeConstant):
+		var values []string
+		err = json.Unmarshal(arguments.Values, &values)
+		model.ConstantValues, valueDiags = types.ListValueFrom(ctx, types.StringType, values)
+	case string(domain.MapVariablePropertiesTypeMap):
+		var values map[string]string
+		err = json.Unmarshal(arguments.Values, &values)
+		model.MapValues, valueDiags = types.MapValueFrom(ctx, types.StringType, values)
+	default:
+		err = fmt.Errorf("variable type %q is not supported", arguments.Type)
+	}
+	if err != nil {
+		diags.AddError("Invalid variable arguments", err.Error())
+		return model, diags
+	}
+	diags.Append(valueDiags...)
+
+	if variable.Selected != nil && len(*variable.Selected) > 0 {
+		model.Selected, valueDiags = types.ListValueFrom(ctx, types.StringType, *variable.Selected)
+		diags.Append(valueDiags...)
+	}
+
+	labelIDs, labelDiags := convertLabelsToIDSet(ctx, variable.Labels)
+	diags.Append(labelDiags...)
+	model.LabelIDs = labelIDs
+
+	return model, diags
+}
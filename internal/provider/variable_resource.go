@@ -0,0 +1,500 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &VariableResource{}
+	_ resource.ResourceWithImportState    = &VariableResource{}
+	_ resource.ResourceWithValidateConfig = &VariableResource{}
+)
+
+// NewVariableResource is a helper function to simplify the provider implementation.
+func NewVariableResource() resource.Resource {
+	return &VariableResource{}
+}
+
+// VariableResource defines the resource implementation.
+type VariableResource struct {
+	client influxdb2.Client
+}
+
+// Metadata returns the resource type name.
+func (r *VariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable"
+}
+
+// Schema defines the schema for the resource.
+func (r *VariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates and manages a dashboard variable. A variable takes its values from a query, a constant list or a key/value map.",
+
+		Attributes: map[string]schema.Attribute{
+			"constant_values": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The values of the variable. Required when `type` is `constant`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the variable was created.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The description of the variable.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The variable ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the labels attached to the variable.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"map_values": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The key/value pairs of the variable. Keys are shown in the dashboard and values are used in queries. Required when `type` is `map`.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the variable, used as `v.<name>` in queries.",
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"query": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The query returning the values of the variable. Required when `type` is `query`.",
+				Attributes: map[string]schema.Attribute{
+					"language": schema.StringAttribute{
+						Computed:    true,
+						Optional:    true,
+						Description: "The query language (`flux` or `influxql`). Defaults to `flux`.",
+						Default:     stringdefault.StaticString("flux"),
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"flux", "influxql"}...),
+						},
+					},
+					"query": schema.StringAttribute{
+						Required:    true,
+						Description: "The query text.",
+					},
+				},
+			},
+			"selected": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The values selected by default.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the variable (`query`, `constant` or `map`).",
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"query", "constant", "map"}...),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the variable was last updated.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ValidateConfig validates that the values attribute matches the variable type.
+func (r *VariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The attributes are read one by one, as the values may be unknown, e.g. when set from a module output
+	var variableType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &variableType)...)
+	if resp.Diagnostics.HasError() || variableType.IsUnknown() || variableType.IsNull() {
+		return
+	}
+
+	attributes := []struct {
+		name         string
+		variableType string
+	}{
+		{"query", "query"},
+		{"constant_values", "constant"},
+		{"map_values", "map"},
+	}
+	for _, attribute := range attributes {
+		var value attr.Value
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute.name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value.IsUnknown() {
+			continue
+		}
+
+		set := !value.IsNull()
+		if attribute.variableType == variableType.ValueString() && !set {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Missing variable values",
+				fmt.Sprintf("The %q attribute must be set when type is %q.", attribute.name, variableType.ValueString()),
+			)
+		}
+		if attribute.variableType != variableType.ValueString() && set {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid variable values",
+				fmt.Sprintf("The %q attribute cannot be set when type is %q.", attribute.name, variableType.ValueString()),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *VariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VariableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan
+	createVariable, diags := convertModelToDomainVariable(ctx, plan.VariableModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResponse, err := r.client.APIClient().PostVariables(ctx, &domain.PostVariablesAllParams{
+		Body: domain.PostVariablesJSONRequestBody(createVariable),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error creating variable",
+			"Could not create variable",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeVariables),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	variable, diags := convertDomainVariableToModel(ctx, apiResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := VariableResourceModel{
+		VariableModel: variable,
+		Timeouts:      plan.Timeouts,
+	}
+
+	// Attach the configured labels
+	err = r.updateLabels(ctx, state.Id.ValueString(), types.SetNull(types.StringType), plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error adding labels to variable",
+			"Could not add labels to variable",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeVariables),
+		))
+
+		return
+	}
+	state.LabelIDs = plan.LabelIDs
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state VariableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed variable value from InfluxDB
+	variable, err := r.client.APIClient().GetVariablesID(ctx, &domain.GetVariablesIDAllParams{
+		VariableID: state.Id.ValueString(),
+	})
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error reading variable",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, state.OrgID.ValueString(), domain.ResourceTypeVariables),
+		))
+
+		return
+	}
+
+	// Overwrite items with refreshed state
+	labelIDs := state.LabelIDs
+	state.VariableModel, diags = convertDomainVariableToModel(ctx, variable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.LabelIDs = refreshedLabelIDs(labelIDs, state.LabelIDs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *VariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VariableResourceModel
+	var state VariableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Read current state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	updateVariable, diags := convertModelToDomainVariable(ctx, plan.VariableModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateVariable.Id = state.Id.ValueStringPointer()
+
+	// Update existing variable
+	apiResponse, err := r.client.APIClient().PutVariablesID(ctx, &domain.PutVariablesIDAllParams{
+		VariableID: state.Id.ValueString(),
+		Body:       domain.PutVariablesIDJSONRequestBody(updateVariable),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating variable",
+			"Could not update variable",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeVariables),
+		))
+
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	variable, diags := convertDomainVariableToModel(ctx, apiResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState := VariableResourceModel{
+		VariableModel: variable,
+		Timeouts:      plan.Timeouts,
+	}
+
+	// Reconcile the attached labels
+	err = r.updateLabels(ctx, state.Id.ValueString(), state.LabelIDs, plan.LabelIDs)
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error updating variable labels",
+			"Could not update variable labels",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, plan.OrgID.ValueString(), domain.ResourceTypeVariables),
+		))
+
+		return
+	}
+	newState.LabelIDs = plan.LabelIDs
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VariableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing variable
+	err := r.client.APIClient().DeleteVariablesID(ctx, &domain.DeleteVariablesIDAllParams{
+		VariableID: state.Id.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Error deleting variable",
+			"Could not delete variable",
+			err,
+			apierror.Permission(domain.PermissionActionWrite, state.OrgID.ValueString(), domain.ResourceTypeVariables),
+		))
+
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *VariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_variable")...)
+
+	r.client = client
+}
+
+func (r *VariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateLabels attaches and detaches labels so the variable carries exactly the desired label IDs
+func (r *VariableResource) updateLabels(ctx context.Context, variableID string, current types.Set, desired types.Set) error {
+	var currentIDs, desiredIDs []string
+	if !current.IsNull() && !current.IsUnknown() {
+		if diags := current.ElementsAs(ctx, &currentIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read current label IDs")
+		}
+	}
+	if !desired.IsNull() && !desired.IsUnknown() {
+		if diags := desired.ElementsAs(ctx, &desiredIDs, false); diags.HasError() {
+			return fmt.Errorf("failed to read desired label IDs")
+		}
+	}
+
+	toAdd, toRemove := diffIDs(currentIDs, desiredIDs)
+	for _, labelID := range toAdd {
+		_, err := r.client.APIClient().PostVariablesIDLabels(ctx, &domain.PostVariablesIDLabelsAllParams{
+			VariableID: variableID,
+			Body:       domain.PostVariablesIDLabelsJSONRequestBody{LabelID: &labelID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add label %s: %w", labelID, err)
+		}
+	}
+
+	for _, labelID := range toRemove {
+		err := r.client.APIClient().DeleteVariablesIDLabelsID(ctx, &domain.DeleteVariablesIDLabelsIDAllParams{
+			VariableID: variableID,
+			LabelID:    labelID,
+		})
+		if err != nil && !apierror.IsNotFound(err) {
+			return fmt.Errorf("failed to remove label %s: %w", labelID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariableResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccVariableResourceConfig("test_variable", "server01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("influxdb_variable.constant", "id"),
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "name", "test_variable"),
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "type", "constant"),
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "org_id", os.Getenv("INFLUXDB_ORG_ID")),
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "constant_values.#", "2"),
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "selected.0", "server01"),
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "label_ids.#", "1"),
+					resource.TestCheckResourceAttr("influxdb_variable.map", "type", "map"),
+					resource.TestCheckResourceAttr("influxdb_variable.map", "map_values.Production", "prod"),
+					resource.TestCheckResourceAttr("influxdb_variable.query", "type", "query"),
+					resource.TestCheckResourceAttr("influxdb_variable.query", "query.language", "flux"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "influxdb_variable.constant",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccVariableResourceConfig("test_variable_updated", "server02"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "name", "test_variable_updated"),
+					resource.TestCheckResourceAttr("influxdb_variable.constant", "selected.0", "server02"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccVariableResourceConfig(name string, selected string) string {
+	return fmt.Sprintf(`
+resource "influxdb_label" "test" {
+  name   = "test-variable-label"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+}
+
+resource "influxdb_variable" "constant" {
+  name            = %[1]q
+  org_id          = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  type            = "constant"
+  constant_values = ["server01", "server02"]
+  selected        = [%[2]q]
+  label_ids       = [influxdb_label.test.id]
+}
+
+resource "influxdb_variable" "map" {
+  name   = "test_variable_map"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  type   = "map"
+  map_values = {
+    "Production" = "prod"
+    "Staging"    = "staging"
+  }
+}
+
+resource "influxdb_variable" "query" {
+  name   = "test_variable_query"
+  org_id = "`+os.Getenv("INFLUXDB_ORG_ID")+`"
+  type   = "query"
+  query = {
+    query = "buckets() |> keep(columns: [\"name\"])"
+  }
+}
+`, name, selected)
+}
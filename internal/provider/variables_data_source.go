@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+
+	"github.com/komminarlabs/terraform-provider-influxdb/internal/apierror"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &VariablesDataSource{}
+	_ datasource.DataSourceWithConfigure = &VariablesDataSource{}
+)
+
+// NewVariablesDataSource is a helper function to simplify the provider implementation.
+func NewVariablesDataSource() datasource.DataSource {
+	return &VariablesDataSource{}
+}
+
+// VariablesDataSource is the data source implementation.
+type VariablesDataSource struct {
+	client influxdb2.Client
+}
+
+// VariablesDataSourceModel describes the data source data model.
+type VariablesDataSourceModel struct {
+	Variables []VariableModel `tfsdk:"variables"`
+}
+
+// Metadata returns the data source type name.
+func (d *VariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+// Schema defines the schema for the data source.
+func (d *VariablesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "List all variables.",
+
+		Attributes: map[string]schema.Attribute{
+			"variables": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"constant_values": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The values of a `constant` variable.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp when the variable was created.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the variable.",
+						},
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The variable ID.",
+						},
+						"label_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the labels attached to the variable.",
+						},
+						"map_values": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The key/value pairs of a `map` variable.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the variable.",
+						},
+						"org_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The organization ID.",
+						},
+						"query": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "The query of a `query` variable.",
+							Attributes: map[string]schema.Attribute{
+								"language": schema.StringAttribute{
+									Computed:    true,
+									Description: "The query language.",
+								},
+								"query": schema.StringAttribute{
+									Computed:    true,
+									Description: "The query text.",
+								},
+							},
+						},
+						"selected": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The values selected by default.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the variable.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp when the variable was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *VariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(influxdb2.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected influxdb2.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(requireAuthentication(client, "influxdb_variables")...)
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *VariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state VariablesDataSourceModel
+
+	variables, err := d.client.APIClient().GetVariables(ctx, &domain.GetVariablesParams{})
+	if err != nil {
+		resp.Diagnostics.Append(apierror.Diagnostic(
+			"Unable to list variables",
+			"",
+			err,
+			apierror.Permission(domain.PermissionActionRead, "", domain.ResourceTypeVariables),
+		))
+
+		return
+	}
+
+	// Map response body to model
+	if variables.Variables != nil {
+		for _, variable := range *variables.Variables {
+			variableState, diags := convertDomainVariableToModel(ctx, &variable)
+			if diags.HasError() {
+				resp.Diagnostics.Append(diags...)
+				return
+			}
+
+			state.Variables = append(state.Variables, variableState)
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariablesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a variable and then read it with data source
+			{
+				Config: providerConfig + testAccVariablesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.influxdb_variables.test", "variables.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.influxdb_variables.test", "variables.*", map[string]string{
+						"name": "test_variables",
+						"type": "constant",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccVariablesDataSourceConfig() string {
+	return `
+resource "influxdb_variable" "test" {
+  name            = "test_variables"
+  org_id          = "` + os.Getenv("INFLUXDB_ORG_ID") + `"
+  type            = "constant"
+  constant_values = ["a", "b"]
+}
+
+data "influxdb_variables" "test" {
+  depends_on = [influxdb_variable.test]
+}
+`
+}